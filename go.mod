@@ -1,11 +1,15 @@
 module github.com/coinbase/protoc-gen-rbi
 
-go 1.13
+go 1.20
 
 require (
-	github.com/davecgh/go-spew v1.1.1 // indirect
-	github.com/kr/pretty v0.1.0 // indirect
 	github.com/lyft/protoc-gen-star v0.5.3
+	google.golang.org/protobuf v1.34.2
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/golang/protobuf v1.5.4 // indirect
+	github.com/spf13/afero v1.3.3 // indirect
 	golang.org/x/text v0.3.8 // indirect
-	gopkg.in/check.v1 v1.0.0-20190902080502-41f04d3bba15 // indirect
 )
@@ -0,0 +1,186 @@
+package rbi_generator
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	pgs "github.com/lyft/protoc-gen-star"
+)
+
+// fileOptions are the options that can be set for all files, or overridden
+// per package and per file in the config file. Unset options are nil.
+type fileOptions struct {
+	GRPC                *bool   `json:"grpc"`
+	HideCommonMethods   *bool   `json:"hide_common_methods"`
+	UseAbstractMessage  *bool   `json:"use_abstract_message"`
+	StrictEnumGetters   *bool   `json:"strict_enum_getters"`
+	QualifyCoreTypes    *bool   `json:"qualify_core_types"`
+	Typed               *string `json:"typed"`
+	FrozenStringLiteral *bool   `json:"frozen_string_literal"`
+	HeaderParameters    *bool   `json:"header_parameters"`
+	Extensions          *bool   `json:"extensions"`
+}
+
+// config is the JSON file given by the `config` parameter, e.g.
+//
+//	{
+//	  "hide_common_methods": true,
+//	  "ruby_namespace": {"acme.billing": "Acme::Billing::Proto"},
+//	  "packages": {"acme.legacy": {"strict_enum_getters": true}},
+//	  "files": {"acme/legacy/old.proto": {"use_abstract_message": false}}
+//	}
+//
+// Package overrides apply to the files of exactly that proto package, file
+// overrides to files by their path relative to the proto_path.
+type config struct {
+	fileOptions
+	RubyNamespace       map[string]string      `json:"ruby_namespace"`
+	RubyNamespacePrefix *string                `json:"ruby_namespace_prefix"`
+	IncludeFiles        []string               `json:"include_files"`
+	ExcludeFiles        []string               `json:"exclude_files"`
+	IncludePackages     []string               `json:"include_packages"`
+	ExcludePackages     []string               `json:"exclude_packages"`
+	IncludeNames        []string               `json:"include_names"`
+	ExcludeNames        []string               `json:"exclude_names"`
+	TemplatesDir        *string                `json:"templates_dir"`
+	HeaderFile          *string                `json:"header_file"`
+	IncludeImports      *bool                  `json:"include_imports"`
+	ExcludeWKTImports   *bool                  `json:"exclude_wkt_imports"`
+	Validate            *bool                  `json:"validate"`
+	Packages            map[string]fileOptions `json:"packages"`
+	Files               map[string]fileOptions `json:"files"`
+}
+
+func loadConfig(path string) (*config, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("config: %v", err)
+	}
+
+	cfg := &config{}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(cfg); err != nil {
+		return nil, fmt.Errorf("config %s: %s", path, strings.TrimPrefix(err.Error(), "json: "))
+	}
+
+	for pkg, namespace := range cfg.RubyNamespace {
+		if !validRubyNamespace.MatchString(namespace) {
+			return nil, fmt.Errorf("config %s: ruby_namespace: invalid Ruby namespace %q for package %s", path, namespace, pkg)
+		}
+	}
+	if cfg.RubyNamespacePrefix != nil && *cfg.RubyNamespacePrefix != "" && !validRubyNamespace.MatchString(*cfg.RubyNamespacePrefix) {
+		return nil, fmt.Errorf("config %s: ruby_namespace_prefix: invalid Ruby namespace %q", path, *cfg.RubyNamespacePrefix)
+	}
+	if err := cfg.fileOptions.validate(); err != nil {
+		return nil, fmt.Errorf("config %s: %v", path, err)
+	}
+	for pkg, opts := range cfg.Packages {
+		if err := opts.validate(); err != nil {
+			return nil, fmt.Errorf("config %s: packages: %s: %v", path, pkg, err)
+		}
+	}
+	for file, opts := range cfg.Files {
+		if err := opts.validate(); err != nil {
+			return nil, fmt.Errorf("config %s: files: %s: %v", path, file, err)
+		}
+	}
+
+	return cfg, nil
+}
+
+func (o fileOptions) validate() error {
+	if o.Typed != nil && !validSigil(*o.Typed) {
+		return fmt.Errorf("typed: %s", sigilError(*o.Typed))
+	}
+	return nil
+}
+
+// sigils are the strictness levels of Sorbet's `# typed:` comment. An empty
+// one omits the comment.
+var sigils = []string{"", "ignore", "false", "true", "strict", "strong"}
+
+func validSigil(sigil string) bool {
+	for _, s := range sigils {
+		if s == sigil {
+			return true
+		}
+	}
+	return false
+}
+
+func sigilError(sigil string) string {
+	return fmt.Sprintf("%q isn't one of %s", sigil, strings.Join(sigils[1:], ", "))
+}
+
+// settings are the effective options of a file.
+type settings struct {
+	grpc                bool
+	hideCommonMethods   bool
+	useAbstractMessage  bool
+	strictEnumGetters   bool
+	qualifyCoreTypes    bool
+	typed               string
+	frozenStringLiteral bool
+	headerParameters    bool
+	extensions          bool
+}
+
+func (o Options) settings() settings {
+	return settings{
+		grpc:                o.GRPC,
+		hideCommonMethods:   o.HideCommonMethods,
+		useAbstractMessage:  o.UseAbstractMessage,
+		strictEnumGetters:   o.StrictEnumGetters,
+		qualifyCoreTypes:    o.QualifyCoreTypes,
+		typed:               o.Typed,
+		frozenStringLiteral: o.FrozenStringLiteral,
+		headerParameters:    o.HeaderParameters,
+		extensions:          o.Extensions,
+	}
+}
+
+func (s *settings) apply(o fileOptions) {
+	if o.GRPC != nil {
+		s.grpc = *o.GRPC
+	}
+	if o.HideCommonMethods != nil {
+		s.hideCommonMethods = *o.HideCommonMethods
+	}
+	if o.UseAbstractMessage != nil {
+		s.useAbstractMessage = *o.UseAbstractMessage
+	}
+	if o.StrictEnumGetters != nil {
+		s.strictEnumGetters = *o.StrictEnumGetters
+	}
+	if o.QualifyCoreTypes != nil {
+		s.qualifyCoreTypes = *o.QualifyCoreTypes
+	}
+	if o.Typed != nil {
+		s.typed = *o.Typed
+	}
+	if o.FrozenStringLiteral != nil {
+		s.frozenStringLiteral = *o.FrozenStringLiteral
+	}
+	if o.HeaderParameters != nil {
+		s.headerParameters = *o.HeaderParameters
+	}
+	if o.Extensions != nil {
+		s.extensions = *o.Extensions
+	}
+}
+
+// settingsFor resolves the options of a file, from lowest to highest
+// precedence: the Options, the config file's top level, package and file
+// overrides, then the command line parameters.
+func (m *Module) settingsFor(file pgs.File) settings {
+	s := m.options.settings()
+	s.apply(m.config.fileOptions)
+	s.apply(m.config.Packages[file.Descriptor().GetPackage()])
+	s.apply(m.config.Files[file.InputPath().String()])
+	s.apply(m.params)
+	return s
+}
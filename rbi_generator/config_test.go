@@ -0,0 +1,55 @@
+package rbi_generator
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLoadConfig(t *testing.T) {
+	cfg, err := loadConfig(filepath.Join("testdata", "config.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.HideCommonMethods == nil || !*cfg.HideCommonMethods {
+		t.Error("hide_common_methods isn't set")
+	}
+	if opts := cfg.Packages["example"]; opts.UseAbstractMessage == nil || !*opts.UseAbstractMessage {
+		t.Error("the use_abstract_message of package example isn't set")
+	}
+	if opts := cfg.Files["services.proto"]; opts.GRPC == nil || *opts.GRPC {
+		t.Error("the grpc of services.proto isn't unset")
+	}
+}
+
+func TestLoadConfigErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		config string
+		err    string
+	}{
+		{"unknown key", `{"hide_comon_methods": true}`, `unknown field "hide_comon_methods"`},
+		{"unknown package key", `{"packages": {"example": {"ruby_namespace": "Example"}}}`, `unknown field "ruby_namespace"`},
+		{"wrong type", `{"grpc": "yes"}`, "cannot unmarshal string into Go struct field"},
+		{"wrong file type", `{"files": {"example.proto": {"typed": true}}}`, "cannot unmarshal bool into Go struct field"},
+		{"invalid sigil", `{"files": {"example.proto": {"typed": "loose"}}}`, `files: example.proto: typed: "loose" isn't one of`},
+		{"invalid namespace", `{"ruby_namespace": {"example": "acme"}}`, `invalid Ruby namespace "acme" for package example`},
+		{"syntax", `{"grpc": true`, "unexpected EOF"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "config.json")
+			if err := ioutil.WriteFile(path, []byte(tt.config), 0644); err != nil {
+				t.Fatal(err)
+			}
+			_, err := loadConfig(path)
+			if err == nil {
+				t.Fatal("got no error")
+			}
+			if !strings.HasPrefix(err.Error(), "config "+path+": ") || !strings.Contains(err.Error(), tt.err) {
+				t.Errorf("got %q, want an error of %s containing %q", err, path, tt.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,286 @@
+package rbi_generator
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/coinbase/protoc-gen-rbi/rbi"
+	"github.com/coinbase/protoc-gen-rbi/ruby_types"
+
+	pgs "github.com/lyft/protoc-gen-star"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+// check collects the errors that would fail rendering the file, so they can
+// all be reported at once instead of aborting on the first.
+func (m *Module) check(g *generator, file pgs.File) []error {
+	errs := make([]error, 0)
+	add := func(entity pgs.Entity, err error) {
+		if err != nil {
+			errs = append(errs, entityError(entity, err))
+		}
+	}
+
+	typeFuncs := []func(pgs.Field) (string, error){
+		g.types.RubyGetterFieldType,
+		g.types.RubySetterFieldType,
+		g.types.RubyInitializerFieldType,
+		g.types.RubyFieldValue,
+	}
+	fields := make([]pgs.Field, 0)
+	for _, message := range m.messages(file) {
+		fields = append(fields, ruby_types.Fields(message)...)
+	}
+	if g.extensions {
+		for _, extension := range m.extensions(file) {
+			fields = append(fields, extension)
+		}
+	}
+	for _, field := range fields {
+		for _, typeFunc := range typeFuncs {
+			if _, err := typeFunc(field); err != nil {
+				add(field, err)
+				break
+			}
+		}
+	}
+
+	return errs
+}
+
+// entityError locates err at the definition of entity, e.g.
+// `legacy.proto:12:3: message example.Foo, field bar: bad rbi options: ...`
+func entityError(entity pgs.Entity, err error) error {
+	position := entity.File().InputPath().String()
+	if info := entity.SourceCodeInfo(); info != nil {
+		if span := info.Location().GetSpan(); len(span) >= 2 {
+			position = fmt.Sprintf("%s:%d:%d", position, span[0]+1, span[1]+1)
+		}
+	} else if e, ok := entity.(pgs.Extension); ok {
+		// pgs doesn't attach the source info of extensions
+		if path := extensionPath(e); path != nil {
+			position = sourcePosition(e.File().Descriptor(), path)
+		}
+	}
+
+	switch e := entity.(type) {
+	case pgs.File:
+		return locatedError(position, "", err)
+	case pgs.Extension:
+		return locatedError(position, "extension "+fullName(e), err)
+	case pgs.Field:
+		return locatedError(position, fmt.Sprintf("message %s, field %s", fullName(e.Message()), e.Name()), err)
+	default:
+		return locatedError(position, fmt.Sprintf("%s %s", entityKind(entity), fullName(entity)), err)
+	}
+}
+
+// joinErrors lists the errors of a response one per line, like compilers
+// report them.
+func joinErrors(errs []error) string {
+	lines := make([]string, len(errs))
+	for i, err := range errs {
+		lines[i] = err.Error()
+	}
+	return strings.Join(lines, "\n")
+}
+
+// locatedError prefixes err with its position, and the entity it's about
+// unless it's the file itself.
+func locatedError(position, subject string, err error) error {
+	if subject == "" {
+		return fmt.Errorf("%s: %v", position, err)
+	}
+	return fmt.Errorf("%s: %s: %v", position, subject, err)
+}
+
+func entityKind(entity pgs.Entity) string {
+	switch entity.(type) {
+	case pgs.Message:
+		return "message"
+	case pgs.Enum:
+		return "enum"
+	case pgs.Service:
+		return "service"
+	}
+	return "entity"
+}
+
+func fullName(entity pgs.Entity) string {
+	return strings.TrimPrefix(entity.FullyQualifiedName(), ".")
+}
+
+// stripBadOptions removes the rbi options that don't decode from the request,
+// e.g. those of another plugin using the same extension number, which would
+// make pgs fail to parse it as a whole. It returns their errors in the files
+// to generate, located like entityError's.
+func stripBadOptions(req *pluginpb.CodeGeneratorRequest) []error {
+	targets := make(map[string]bool)
+	for _, name := range req.GetFileToGenerate() {
+		targets[name] = true
+	}
+
+	errs := make([]error, 0)
+	for _, file := range req.GetProtoFile() {
+		c := &optionsChecker{file: file, errs: make([]error, 0)}
+		c.check()
+		if targets[file.GetName()] {
+			errs = append(errs, c.errs...)
+		}
+	}
+	return errs
+}
+
+// optionsChecker strips the bad rbi options of a file, its messages, fields
+// and extensions, following the paths of their source code info.
+type optionsChecker struct {
+	file *descriptorpb.FileDescriptorProto
+	errs []error
+}
+
+// Field numbers of the descriptors, as used in source code info paths.
+const (
+	fileMessagesPath     = 4
+	fileExtensionsPath   = 7
+	messageFieldsPath    = 2
+	messageNestedPath    = 3
+	messageExtensionPath = 6
+)
+
+func (c *optionsChecker) check() {
+	c.strip(c.file.GetOptions(), rbi.E_File, nil, "")
+	for i, message := range c.file.GetMessageType() {
+		c.checkMessage(message, []int32{fileMessagesPath, int32(i)}, c.file.GetPackage())
+	}
+	for i, extension := range c.file.GetExtension() {
+		name := qualifiedName(c.file.GetPackage(), extension.GetName())
+		c.strip(extension.GetOptions(), rbi.E_Field, []int32{fileExtensionsPath, int32(i)}, "extension "+name)
+	}
+}
+
+func (c *optionsChecker) checkMessage(message *descriptorpb.DescriptorProto, path []int32, scope string) {
+	name := qualifiedName(scope, message.GetName())
+	c.strip(message.GetOptions(), rbi.E_Message, path, "message "+name)
+	for i, field := range message.GetField() {
+		subject := fmt.Sprintf("message %s, field %s", name, field.GetName())
+		c.strip(field.GetOptions(), rbi.E_Field, appendPath(path, messageFieldsPath, i), subject)
+	}
+	for i, extension := range message.GetExtension() {
+		subject := "extension " + qualifiedName(name, extension.GetName())
+		c.strip(extension.GetOptions(), rbi.E_Field, appendPath(path, messageExtensionPath, i), subject)
+	}
+	for i, nested := range message.GetNestedType() {
+		c.checkMessage(nested, appendPath(path, messageNestedPath, i), name)
+	}
+}
+
+// strip removes the extension from the options' unknown fields if it doesn't
+// decode, and records the error. The runtime merges repeated occurrences of a
+// message extension, so they're decoded together.
+func (c *optionsChecker) strip(options proto.Message, extension protoreflect.ExtensionType, path []int32, subject string) {
+	m := options.ProtoReflect()
+	if !m.IsValid() {
+		return
+	}
+	number := extension.TypeDescriptor().Number()
+
+	var kept protoreflect.RawFields
+	var value []byte
+	found := false
+	for b := m.GetUnknown(); len(b) > 0; {
+		num, typ, n := protowire.ConsumeField(b)
+		if n < 0 {
+			return
+		}
+		if num == number && typ == protowire.BytesType {
+			v, _ := protowire.ConsumeBytes(b[protowire.SizeTag(num):n])
+			value = append(value, v...)
+			found = true
+		} else {
+			kept = append(kept, b[:n]...)
+		}
+		b = b[n:]
+	}
+	if !found {
+		return
+	}
+	// protobuf's errors are worded differently from build to build, so they
+	// aren't relied on, the type that fails to decode is reported instead
+	rbiOptions := extension.New().Message().Interface()
+	if err := proto.Unmarshal(value, rbiOptions); err != nil {
+		m.SetUnknown(kept)
+		err = fmt.Errorf("bad rbi options: not an encoded %s", rbiOptions.ProtoReflect().Descriptor().FullName())
+		c.errs = append(c.errs, locatedError(c.position(path), subject, err))
+	}
+}
+
+// position returns the file, line and column the entity at path is defined at,
+// or the file alone without source code info.
+func (c *optionsChecker) position(path []int32) string {
+	return sourcePosition(c.file, path)
+}
+
+// sourcePosition is the line:column of the declaration at path in file, or
+// just the file without source info.
+func sourcePosition(file *descriptorpb.FileDescriptorProto, path []int32) string {
+	position := file.GetName()
+	for _, location := range file.GetSourceCodeInfo().GetLocation() {
+		if span := location.GetSpan(); len(span) >= 2 && equalPaths(location.GetPath(), path) {
+			return fmt.Sprintf("%s:%d:%d", position, span[0]+1, span[1]+1)
+		}
+	}
+	return position
+}
+
+// extensionPath is the source path of e, in the extensions of its file or
+// of the message it's declared in.
+func extensionPath(e pgs.Extension) []int32 {
+	var path []int32
+	var extensions []*descriptorpb.FieldDescriptorProto
+	field := int32(fileExtensionsPath)
+	switch parent := e.DefinedIn().(type) {
+	case pgs.File:
+		extensions = parent.Descriptor().GetExtension()
+	case pgs.Message:
+		if parent.SourceCodeInfo() == nil {
+			return nil
+		}
+		path = parent.SourceCodeInfo().Location().GetPath()
+		extensions = parent.Descriptor().GetExtension()
+		field = messageExtensionPath
+	}
+	for i, extension := range extensions {
+		if extension == e.Descriptor() {
+			return appendPath(path, field, i)
+		}
+	}
+	return nil
+}
+
+func appendPath(path []int32, field int32, index int) []int32 {
+	return append(append(append([]int32{}, path...), field), int32(index))
+}
+
+func equalPaths(a, b []int32) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// qualifiedName returns the full name of name in the package or message scope.
+func qualifiedName(scope, name string) string {
+	if scope == "" {
+		return name
+	}
+	return scope + "." + name
+}
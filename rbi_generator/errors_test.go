@@ -0,0 +1,47 @@
+package rbi_generator
+
+import (
+	"errors"
+	"testing"
+
+	pgs "github.com/lyft/protoc-gen-star"
+
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+// Errors about an entity are located at its declaration, and name it unless
+// it's the file itself.
+func TestEntityError(t *testing.T) {
+	req := &pluginpb.CodeGeneratorRequest{
+		FileToGenerate: []string{"example.proto", "extensions.proto", "proto2.proto"},
+		ProtoFile:      loadDescriptorSet(t, "testdata"),
+	}
+	rewriteGroups(req)
+	ast := pgs.ProcessCodeGeneratorRequest(pgs.InitMockDebugger(), req)
+	lookup := func(name string) pgs.Entity {
+		entity, ok := ast.Lookup(name)
+		if !ok {
+			t.Fatalf("%s isn't in the descriptor set", name)
+		}
+		return entity
+	}
+
+	err := errors.New("unsupported field type group")
+	cases := []struct {
+		entity pgs.Entity
+		want   string
+	}{
+		{ast.Targets()["example.proto"], "example.proto:1:1: unsupported field type group"},
+		{lookup(".example.Request"), "example.proto:5:1: message example.Request: unsupported field type group"},
+		{lookup(".example.Request.name"), "example.proto:6:3: message example.Request, field name: unsupported field type group"},
+		{lookup(".example.Greeter"), "example.proto:13:1: service example.Greeter: unsupported field type group"},
+		{lookup(".example.Sensitivity"), "extensions.proto:7:1: enum example.Sensitivity: unsupported field type group"},
+		{lookup(".example.column_name"), "extensions.proto:18:3: extension example.column_name: unsupported field type group"},
+		{lookup(".example.Audit.audited"), "extensions.proto:26:5: extension example.Audit.audited: unsupported field type group"},
+	}
+	for _, c := range cases {
+		if got := entityError(c.entity, err).Error(); got != c.want {
+			t.Errorf("entityError(%s) = %q, want %q", c.entity.FullyQualifiedName(), got, c.want)
+		}
+	}
+}
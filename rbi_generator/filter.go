@@ -0,0 +1,118 @@
+package rbi_generator
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/coinbase/protoc-gen-rbi/ruby_types"
+
+	pgs "github.com/lyft/protoc-gen-star"
+)
+
+// filter selects the files, messages, enums, services and extensions to
+// generate from include and exclude globs. Empty includes select everything.
+type filter struct {
+	includeFiles    []*regexp.Regexp
+	excludeFiles    []*regexp.Regexp
+	includePackages []*regexp.Regexp
+	excludePackages []*regexp.Regexp
+	includeNames    []*regexp.Regexp
+	excludeNames    []*regexp.Regexp
+}
+
+// The separators of the components globs are matched against.
+const (
+	pathSeparator = '/'
+	nameSeparator = '.'
+)
+
+// compileGlobs compiles globs matched against file paths, or dotted package
+// and type names: `*` and `?` match within a component, `**` across
+// components.
+func compileGlobs(globs []string, separator byte) ([]*regexp.Regexp, error) {
+	component := "[^" + regexp.QuoteMeta(string(separator)) + "]"
+	res := make([]*regexp.Regexp, 0, len(globs))
+	for _, glob := range globs {
+		if glob == "" {
+			return nil, fmt.Errorf("empty glob")
+		}
+		var expr strings.Builder
+		expr.WriteString("^")
+		for i := 0; i < len(glob); i++ {
+			switch {
+			case strings.HasPrefix(glob[i:], "**"):
+				expr.WriteString(".*")
+				i++
+			case glob[i] == '*':
+				expr.WriteString(component + "*")
+			case glob[i] == '?':
+				expr.WriteString(component)
+			default:
+				expr.WriteString(regexp.QuoteMeta(glob[i : i+1]))
+			}
+		}
+		expr.WriteString("$")
+		re, err := regexp.Compile(expr.String())
+		if err != nil {
+			return nil, fmt.Errorf("bad glob %q: %v", glob, err)
+		}
+		res = append(res, re)
+	}
+	return res, nil
+}
+
+func matchAny(globs []*regexp.Regexp, s string) bool {
+	for _, glob := range globs {
+		if glob.MatchString(s) {
+			return true
+		}
+	}
+	return false
+}
+
+func selected(include, exclude []*regexp.Regexp, s string) bool {
+	return (len(include) == 0 || matchAny(include, s)) && !matchAny(exclude, s)
+}
+
+// file reports whether the file is generated, by its path relative to the
+// proto_path and its package.
+func (f *filter) file(file pgs.File) bool {
+	return selected(f.includeFiles, f.excludeFiles, file.InputPath().String()) &&
+		selected(f.includePackages, f.excludePackages, file.Descriptor().GetPackage())
+}
+
+// name reports whether the message or enum is generated, by its fully
+// qualified name. Nested types follow the messages they're nested in, e.g.
+// excluding `example.Foo` excludes `example.Foo.Bar` too.
+func (f *filter) name(entity ruby_types.EntityWithParent) bool {
+	included, excluded := f.matchName(entity)
+	return (len(f.includeNames) == 0 || included) && !excluded
+}
+
+func (f *filter) matchName(entity ruby_types.EntityWithParent) (included, excluded bool) {
+	name := fullName(entity)
+	included, excluded = matchAny(f.includeNames, name), matchAny(f.excludeNames, name)
+	if parent, ok := entity.Parent().(pgs.Message); ok {
+		parentIncluded, parentExcluded := f.matchName(parent)
+		included, excluded = included || parentIncluded, excluded || parentExcluded
+	}
+	return included, excluded
+}
+
+// extension reports whether the extension is generated, by its fully qualified
+// name. Extensions defined in a message follow it.
+func (f *filter) extension(extension pgs.Extension) bool {
+	name := fullName(extension)
+	included, excluded := matchAny(f.includeNames, name), matchAny(f.excludeNames, name)
+	if message, ok := extension.DefinedIn().(pgs.Message); ok {
+		messageIncluded, messageExcluded := f.matchName(message)
+		included, excluded = included || messageIncluded, excluded || messageExcluded
+	}
+	return (len(f.includeNames) == 0 || included) && !excluded
+}
+
+// service reports whether the service is generated, by its fully qualified name.
+func (f *filter) service(service pgs.Service) bool {
+	return selected(f.includeNames, f.excludeNames, fullName(service))
+}
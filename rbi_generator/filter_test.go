@@ -0,0 +1,46 @@
+package rbi_generator
+
+import (
+	"testing"
+)
+
+func TestCompileGlobs(t *testing.T) {
+	tests := []struct {
+		glob      string
+		separator byte
+		s         string
+		match     bool
+	}{
+		// file paths, whose components are separated by /
+		{"subdir/*", pathSeparator, "subdir/messages.proto", true},
+		{"subdir/*", pathSeparator, "subdir/nested/messages.proto", false},
+		{"*.proto", pathSeparator, "foo.v1.proto", true},
+		{"*.proto", pathSeparator, "subdir/foo.proto", false},
+		{"**/*.proto", pathSeparator, "a/b/foo.proto", true},
+		{"broken_*.proto", pathSeparator, "broken_field_name.proto", true},
+		{"foo?.proto", pathSeparator, "foo1.proto", true},
+		{"foo?.proto", pathSeparator, "foo/.proto", false},
+		// package and type names, whose components are separated by .
+		{"example.*", nameSeparator, "example.Response", true},
+		{"example.*", nameSeparator, "example.Response.Nested", false},
+		{"example.**", nameSeparator, "example.Response.Nested", true},
+		{"*", nameSeparator, "testdata.subdir", false},
+		{"testdata.*", nameSeparator, "testdata.subdir", true},
+		{"a/b.*", nameSeparator, "a/b.c", true},
+		{"example.Respons?", nameSeparator, "example.Response", true},
+		{"example?Response", nameSeparator, "example.Response", false},
+	}
+	for _, test := range tests {
+		globs, err := compileGlobs([]string{test.glob}, test.separator)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := matchAny(globs, test.s); got != test.match {
+			t.Errorf("%q (separator %q) matching %q = %t, want %t", test.glob, test.separator, test.s, got, test.match)
+		}
+	}
+
+	if _, err := compileGlobs([]string{""}, nameSeparator); err == nil {
+		t.Error("empty glob compiled")
+	}
+}
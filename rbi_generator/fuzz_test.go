@@ -0,0 +1,563 @@
+package rbi_generator
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+// FuzzGenerate generates random but valid files, checking that the generator
+// doesn't panic or fail, and that the files it writes pass checkRBI. Run it
+// with `go test ./rbi_generator -run '^$' -fuzz FuzzGenerate`.
+func FuzzGenerate(f *testing.F) {
+	f.Add([]byte{})
+	f.Add([]byte("protoc-gen-rbi"))
+	seed := make([]byte, 256)
+	for i := range seed {
+		seed[i] = byte(i * 7)
+	}
+	f.Add(seed)
+
+	wrappers := wrappersFile(f)
+	f.Fuzz(func(t *testing.T, data []byte) {
+		r := &fuzzReader{data: data}
+		files := randomFiles(r, wrappers)
+		req := &pluginpb.CodeGeneratorRequest{
+			FileToGenerate: []string{files[1].GetName(), files[2].GetName()},
+			Parameter:      proto.String(randomParameters(r)),
+			ProtoFile:      files,
+		}
+
+		res, err := Generate(req, DefaultOptions())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.Error != nil {
+			t.Fatalf("%s\n%s", res.GetError(), describeFiles(files))
+		}
+		for _, file := range res.File {
+			if err := checkRBI([]byte(file.GetContent())); err != nil {
+				t.Errorf("%s:%v\n%s", file.GetName(), err, file.GetContent())
+			}
+		}
+	})
+}
+
+func wrappersFile(tb testing.TB) *descriptorpb.FileDescriptorProto {
+	for _, file := range loadDescriptorSet(tb, "testdata") {
+		if file.GetName() == "google/protobuf/wrappers.proto" {
+			return file
+		}
+	}
+	tb.Fatal("google/protobuf/wrappers.proto isn't in the descriptor set")
+	return nil
+}
+
+func describeFiles(files []*descriptorpb.FileDescriptorProto) string {
+	var b strings.Builder
+	for _, file := range files[1:] {
+		fmt.Fprintf(&b, "%v\n", file)
+	}
+	return b.String()
+}
+
+// fuzzReader makes the random choices from the fuzzer's data, choosing the
+// first option once it runs out.
+type fuzzReader struct {
+	data []byte
+}
+
+func (r *fuzzReader) intn(n int) int {
+	if len(r.data) == 0 || n <= 1 {
+		return 0
+	}
+	b := r.data[0]
+	r.data = r.data[1:]
+	return int(b) % n
+}
+
+func (r *fuzzReader) bool() bool { return r.intn(2) == 1 }
+
+func (r *fuzzReader) pick(choices []string) string { return choices[r.intn(len(choices))] }
+
+// odd but valid proto identifiers, many of them Ruby keywords or methods
+var (
+	fieldNames = []string{
+		"name", "foo_bar", "fooBar", "FooBar", "FOO", "_foo", "foo_", "foo__bar", "f00", "x", "hash", "_hash",
+		"end", "class", "def", "self", "nil", "then", "initialize", "method", "send", "to_h", "__END__", "T",
+	}
+	typeNames = []string{
+		"Foo", "foo", "FOO", "Foo_Bar", "foo_bar", "fooBar", "Foo2", "X", "T", "Class", "String", "Hash",
+		"Object", "Integer", "Google", "Protobuf", "Message", "Value", "Entry",
+	}
+	enumValueNames = []string{
+		"FOO", "foo", "Foo", "_FOO", "FOO_BAR", "A1", "END", "end", "nil", "TRUE", "UNSPECIFIED", "x",
+	}
+	packageNames = []string{
+		"", "fuzz", "fuzz.v1beta1", "a.b_c.d", "Upper.Case", "google.api", "fuzz.foo_bar",
+	}
+	rubyPackages   = []string{"", "Fuzz", "Fuzz::Proto", "::Fuzz::Deep::Nesting"}
+	stringDefaults = []string{"", "abc", `"quoted"`, `#{interpolated}`, `back\slash`, "new\nline", "'single'"}
+)
+
+var (
+	scalarTypes = []descriptorpb.FieldDescriptorProto_Type{
+		descriptorpb.FieldDescriptorProto_TYPE_DOUBLE,
+		descriptorpb.FieldDescriptorProto_TYPE_FLOAT,
+		descriptorpb.FieldDescriptorProto_TYPE_INT64,
+		descriptorpb.FieldDescriptorProto_TYPE_UINT64,
+		descriptorpb.FieldDescriptorProto_TYPE_INT32,
+		descriptorpb.FieldDescriptorProto_TYPE_FIXED64,
+		descriptorpb.FieldDescriptorProto_TYPE_FIXED32,
+		descriptorpb.FieldDescriptorProto_TYPE_BOOL,
+		descriptorpb.FieldDescriptorProto_TYPE_STRING,
+		descriptorpb.FieldDescriptorProto_TYPE_BYTES,
+		descriptorpb.FieldDescriptorProto_TYPE_UINT32,
+		descriptorpb.FieldDescriptorProto_TYPE_SFIXED32,
+		descriptorpb.FieldDescriptorProto_TYPE_SFIXED64,
+		descriptorpb.FieldDescriptorProto_TYPE_SINT32,
+		descriptorpb.FieldDescriptorProto_TYPE_SINT64,
+	}
+	// the scalar types map keys can have
+	keyTypes = []descriptorpb.FieldDescriptorProto_Type{
+		descriptorpb.FieldDescriptorProto_TYPE_INT64,
+		descriptorpb.FieldDescriptorProto_TYPE_UINT64,
+		descriptorpb.FieldDescriptorProto_TYPE_INT32,
+		descriptorpb.FieldDescriptorProto_TYPE_FIXED64,
+		descriptorpb.FieldDescriptorProto_TYPE_FIXED32,
+		descriptorpb.FieldDescriptorProto_TYPE_BOOL,
+		descriptorpb.FieldDescriptorProto_TYPE_STRING,
+		descriptorpb.FieldDescriptorProto_TYPE_UINT32,
+		descriptorpb.FieldDescriptorProto_TYPE_SFIXED32,
+		descriptorpb.FieldDescriptorProto_TYPE_SFIXED64,
+		descriptorpb.FieldDescriptorProto_TYPE_SINT32,
+		descriptorpb.FieldDescriptorProto_TYPE_SINT64,
+	}
+	wrapperTypes = []string{
+		".google.protobuf.DoubleValue", ".google.protobuf.FloatValue", ".google.protobuf.Int64Value",
+		".google.protobuf.UInt64Value", ".google.protobuf.Int32Value", ".google.protobuf.UInt32Value",
+		".google.protobuf.BoolValue", ".google.protobuf.StringValue", ".google.protobuf.BytesValue",
+	}
+)
+
+func randomParameters(r *fuzzReader) string {
+	params := []string{
+		fmt.Sprintf("grpc=%t", r.bool()),
+		fmt.Sprintf("hide_common_methods=%t", r.bool()),
+		fmt.Sprintf("use_abstract_message=%t", r.bool()),
+		fmt.Sprintf("strict_enum_getters=%t", r.bool()),
+		fmt.Sprintf("qualify_core_types=%t", r.bool()),
+		fmt.Sprintf("extensions=%t", r.bool()),
+		// invalid Ruby is reported as an error rather than failing the
+		// formatter, which would exit the fuzzer
+		"validate=true",
+	}
+	if r.bool() {
+		params = append(params, "ruby_namespace_prefix=Vendor::Fuzz")
+	}
+	return strings.Join(params, ",")
+}
+
+// fileBuilder builds a file, keeping track of the types the files it's built
+// after define so its fields can reference them.
+type fileBuilder struct {
+	r    *fuzzReader
+	file *descriptorpb.FileDescriptorProto
+	// the file is proto2 unless it's proto3 or uses edition 2023, which sets
+	// random features instead of proto2 labels
+	proto3, editions bool
+	// whether the enums of the file are closed, which proto3 fields can't
+	// reference
+	closedEnums bool
+	// fully qualified names of the messages and enums fields can reference
+	messages []string
+	enums    []string
+	// fully qualified names of the proto2 messages with an extension range,
+	// and the extensions numbers they have used
+	extendable       []string
+	extensionNumbers map[string]int32
+	// the messages declared whose fields are yet to be added
+	pending map[*descriptorpb.DescriptorProto]*pendingMessage
+	// the names used in each package, shared by the files since they can't
+	// define the same name twice in a package
+	packages map[string]map[string]bool
+}
+
+// randomFiles returns the wrappers, a file importing them, and a file importing
+// both, each either proto2, proto3 or edition 2023.
+func randomFiles(r *fuzzReader, wrappers *descriptorpb.FileDescriptorProto) []*descriptorpb.FileDescriptorProto {
+	packages := make(map[string]map[string]bool)
+	extensionNumbers := make(map[string]int32)
+	base := &fileBuilder{r: r, messages: wrapperTypes, packages: packages, extensionNumbers: extensionNumbers}
+	base.syntax()
+	base.packageNames(wrappers.GetPackage())
+	base.build("fuzz/base.proto", wrappers.GetName())
+
+	b := &fileBuilder{
+		r:                r,
+		messages:         base.messages,
+		packages:         packages,
+		extendable:       base.extendable,
+		extensionNumbers: extensionNumbers,
+	}
+	b.syntax()
+	// proto3 fields can't reference closed enums
+	if !b.proto3 || !base.closedEnums {
+		b.enums = base.enums
+	}
+	b.build("fuzz/fuzz.proto", wrappers.GetName(), base.file.GetName())
+
+	return []*descriptorpb.FileDescriptorProto{wrappers, base.file, b.file}
+}
+
+func (b *fileBuilder) syntax() {
+	switch b.r.intn(3) {
+	case 1:
+		b.proto3 = true
+	case 2:
+		b.editions = true
+		b.closedEnums = b.r.bool()
+	default:
+		b.closedEnums = true
+	}
+}
+
+func (b *fileBuilder) build(name string, dependencies ...string) {
+	b.pending = make(map[*descriptorpb.DescriptorProto]*pendingMessage)
+	b.file = &descriptorpb.FileDescriptorProto{
+		Name:       proto.String(name),
+		Package:    proto.String(b.r.pick(packageNames)),
+		Dependency: dependencies,
+		Options:    &descriptorpb.FileOptions{},
+	}
+	switch {
+	case b.proto3:
+		b.file.Syntax = proto.String("proto3")
+	case b.editions:
+		b.file.Syntax = proto.String("editions")
+		b.file.Edition = descriptorpb.Edition_EDITION_2023.Enum()
+		if b.closedEnums {
+			b.file.Options.Features = &descriptorpb.FeatureSet{EnumType: descriptorpb.FeatureSet_CLOSED.Enum()}
+		}
+	default:
+		b.file.Syntax = proto.String("proto2")
+	}
+	if rubyPackage := b.r.pick(rubyPackages); rubyPackage != "" {
+		b.file.Options.RubyPackage = proto.String(rubyPackage)
+	}
+
+	scope := "." + b.file.GetPackage()
+	if b.file.GetPackage() == "" {
+		scope = ""
+	}
+	names := b.packageNames(b.file.GetPackage())
+	// types are declared first, so fields can reference them whatever their order
+	messages := make([]*descriptorpb.DescriptorProto, 1+b.r.intn(3))
+	for i := range messages {
+		messages[i] = b.declareMessage(scope, names, 0)
+	}
+	for i := 0; i < b.r.intn(3); i++ {
+		b.file.EnumType = append(b.file.EnumType, b.enum(scope, names))
+	}
+	for _, message := range messages {
+		b.fields(message)
+	}
+	b.file.MessageType = messages
+
+	for i := 0; i < b.r.intn(3); i++ {
+		b.file.Service = append(b.file.Service, b.service(names))
+	}
+
+	// proto3 files can only extend the options of descriptor.proto
+	if !b.proto3 && len(b.extendable) > 0 {
+		for i := 0; i < b.r.intn(4); i++ {
+			b.file.Extension = append(b.file.Extension, b.extension(names))
+		}
+	}
+}
+
+// packageNames returns the names used in the package, which include the next
+// component of the packages nested in it.
+func (b *fileBuilder) packageNames(pkg string) map[string]bool {
+	scope := ""
+	for _, component := range strings.Split(pkg, ".") {
+		if component == "" {
+			break
+		}
+		b.scope(scope)[component] = true
+		if scope != "" {
+			scope += "."
+		}
+		scope += component
+	}
+	return b.scope(pkg)
+}
+
+func (b *fileBuilder) scope(pkg string) map[string]bool {
+	if b.packages[pkg] == nil {
+		b.packages[pkg] = make(map[string]bool)
+	}
+	return b.packages[pkg]
+}
+
+// unique returns a name from choices that isn't used in its scope yet.
+func (b *fileBuilder) unique(choices []string, names map[string]bool) string {
+	name := b.r.pick(choices)
+	for i := 2; names[name]; i++ {
+		name = fmt.Sprintf("%s%d", b.r.pick(choices), i)
+	}
+	names[name] = true
+	return name
+}
+
+// pendingMessage is a message declared without its fields yet.
+type pendingMessage struct {
+	// the message's full name, and the names used in it
+	scope  string
+	names  map[string]bool
+	nested []*descriptorpb.DescriptorProto
+}
+
+// declareMessage declares a message and its nested types, without fields.
+func (b *fileBuilder) declareMessage(scope string, names map[string]bool, depth int) *descriptorpb.DescriptorProto {
+	message := &descriptorpb.DescriptorProto{Name: proto.String(b.unique(typeNames, names))}
+	fullName := scope + "." + message.GetName()
+	b.messages = append(b.messages, fullName)
+
+	p := &pendingMessage{scope: fullName, names: make(map[string]bool)}
+	if depth < 3 {
+		for i := 0; i < b.r.intn(3); i++ {
+			p.nested = append(p.nested, b.declareMessage(fullName, p.names, depth+1))
+		}
+	}
+	for i := 0; i < b.r.intn(2); i++ {
+		message.EnumType = append(message.EnumType, b.enum(fullName, p.names))
+	}
+	b.pending[message] = p
+	return message
+}
+
+func (b *fileBuilder) enum(scope string, names map[string]bool) *descriptorpb.EnumDescriptorProto {
+	enum := &descriptorpb.EnumDescriptorProto{Name: proto.String(b.unique(typeNames, names))}
+	b.enums = append(b.enums, scope+"."+enum.GetName())
+
+	// enum values are scoped like their enum, so they can't clash with the
+	// other names of its scope either
+	n := 1 + b.r.intn(4)
+	for i := 0; i < n; i++ {
+		number := int32(i)
+		// open enums must start with 0
+		if i > 0 || b.closedEnums {
+			number = int32(b.r.intn(200) - 100)
+		}
+		for _, value := range enum.Value {
+			if value.GetNumber() == number {
+				number += 200
+			}
+		}
+		enum.Value = append(enum.Value, &descriptorpb.EnumValueDescriptorProto{
+			Name:   proto.String(b.unique(enumValueNames, names)),
+			Number: proto.Int32(number),
+		})
+	}
+	return enum
+}
+
+// fields adds the fields of the message and of its nested messages.
+func (b *fileBuilder) fields(message *descriptorpb.DescriptorProto) {
+	p := b.pending[message]
+	delete(b.pending, message)
+
+	oneofs := b.r.intn(3)
+	for i := 0; i < oneofs; i++ {
+		message.OneofDecl = append(message.OneofDecl, &descriptorpb.OneofDescriptorProto{
+			Name: proto.String(b.unique(fieldNames, p.names)),
+		})
+	}
+
+	number := int32(0)
+	// the synthetic oneofs of proto3 optional fields come after the others
+	var synthetic []*descriptorpb.FieldDescriptorProto
+	n := b.r.intn(8)
+	for i := 0; i < n; i++ {
+		number += int32(1 + b.r.intn(1000))
+		field := &descriptorpb.FieldDescriptorProto{
+			Name:   proto.String(b.unique(fieldNames, p.names)),
+			Number: proto.Int32(number),
+			Label:  descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+		}
+
+		switch b.r.intn(6) {
+		case 0:
+			b.mapField(field, p)
+		case 1:
+			field.Label = descriptorpb.FieldDescriptorProto_LABEL_REPEATED.Enum()
+			b.fieldType(field)
+		case 2:
+			if oneofs > 0 {
+				field.OneofIndex = proto.Int32(int32(b.r.intn(oneofs)))
+			}
+			b.fieldType(field)
+		case 3:
+			switch {
+			case b.proto3:
+				field.Proto3Optional = proto.Bool(true)
+				synthetic = append(synthetic, field)
+			case b.editions:
+				field.Options = &descriptorpb.FieldOptions{Features: &descriptorpb.FeatureSet{
+					FieldPresence: descriptorpb.FeatureSet_LEGACY_REQUIRED.Enum(),
+				}}
+			default:
+				field.Label = descriptorpb.FieldDescriptorProto_LABEL_REQUIRED.Enum()
+			}
+			b.fieldType(field)
+		default:
+			b.fieldType(field)
+			switch {
+			case !b.proto3 && field.GetType() == descriptorpb.FieldDescriptorProto_TYPE_STRING && b.r.bool():
+				field.DefaultValue = proto.String(b.r.pick(stringDefaults))
+			// closed enums and messages can't have implicit presence
+			case b.editions && field.TypeName == nil && b.r.bool():
+				field.Options = &descriptorpb.FieldOptions{Features: &descriptorpb.FeatureSet{
+					FieldPresence: descriptorpb.FeatureSet_IMPLICIT.Enum(),
+				}}
+			// groups are rewritten to message fields whatever their message
+			case !b.proto3 && !b.editions && field.GetType() == descriptorpb.FieldDescriptorProto_TYPE_MESSAGE && b.r.intn(4) == 0:
+				field.Type = descriptorpb.FieldDescriptorProto_TYPE_GROUP.Enum()
+			}
+		}
+		message.Field = append(message.Field, field)
+	}
+	for _, field := range synthetic {
+		field.OneofIndex = proto.Int32(int32(len(message.OneofDecl)))
+		message.OneofDecl = append(message.OneofDecl, &descriptorpb.OneofDescriptorProto{
+			Name: proto.String(b.unique([]string{"_" + field.GetName()}, p.names)),
+		})
+	}
+
+	if !b.proto3 && b.r.intn(3) == 0 {
+		message.ExtensionRange = []*descriptorpb.DescriptorProto_ExtensionRange{
+			{Start: proto.Int32(extensionsStart), End: proto.Int32(extensionsStart + 10000)},
+		}
+		b.extendable = append(b.extendable, p.scope)
+	}
+
+	for _, nested := range p.nested {
+		// map entries already have their fields
+		if _, ok := b.pending[nested]; ok {
+			b.fields(nested)
+		}
+		message.NestedType = append(message.NestedType, nested)
+	}
+}
+
+// extensionsStart is the start of the extension range, above the numbers of
+// the fields
+const extensionsStart = 10000
+
+// extension returns an extension of one of the extendable messages.
+func (b *fileBuilder) extension(names map[string]bool) *descriptorpb.FieldDescriptorProto {
+	extendee := b.extendable[b.r.intn(len(b.extendable))]
+	b.extensionNumbers[extendee]++
+	extension := &descriptorpb.FieldDescriptorProto{
+		Name:     proto.String(b.unique(fieldNames, names)),
+		Number:   proto.Int32(extensionsStart + b.extensionNumbers[extendee]),
+		Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+		Extendee: proto.String(extendee),
+	}
+	if b.r.bool() {
+		extension.Label = descriptorpb.FieldDescriptorProto_LABEL_REPEATED.Enum()
+	}
+	b.fieldType(extension)
+	return extension
+}
+
+// fieldType sets the field to a scalar, enum, message or wrapper type.
+func (b *fileBuilder) fieldType(field *descriptorpb.FieldDescriptorProto) {
+	switch {
+	case b.r.intn(3) == 0 && len(b.enums) > 0:
+		field.Type = descriptorpb.FieldDescriptorProto_TYPE_ENUM.Enum()
+		field.TypeName = proto.String(b.enums[b.r.intn(len(b.enums))])
+	case b.r.intn(2) == 0:
+		field.Type = descriptorpb.FieldDescriptorProto_TYPE_MESSAGE.Enum()
+		field.TypeName = proto.String(b.messages[b.r.intn(len(b.messages))])
+	default:
+		field.Type = scalarTypes[b.r.intn(len(scalarTypes))].Enum()
+	}
+}
+
+// mapField makes the field a map, adding its entry message to the message's
+// nested types like protoc does.
+func (b *fileBuilder) mapField(field *descriptorpb.FieldDescriptorProto, p *pendingMessage) {
+	entryName := mapEntryName(field.GetName())
+	for i := 2; p.names[entryName]; i++ {
+		field.Name = proto.String(fmt.Sprintf("%s%d", field.GetName(), i))
+		entryName = mapEntryName(field.GetName())
+	}
+	p.names[field.GetName()] = true
+	p.names[entryName] = true
+
+	value := &descriptorpb.FieldDescriptorProto{
+		Name:   proto.String("value"),
+		Number: proto.Int32(2),
+		Label:  descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+	}
+	b.fieldType(value)
+	entry := &descriptorpb.DescriptorProto{
+		Name: proto.String(entryName),
+		Field: []*descriptorpb.FieldDescriptorProto{
+			{
+				Name:   proto.String("key"),
+				Number: proto.Int32(1),
+				Label:  descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+				Type:   keyTypes[b.r.intn(len(keyTypes))].Enum(),
+			},
+			value,
+		},
+		Options: &descriptorpb.MessageOptions{MapEntry: proto.Bool(true)},
+	}
+	p.nested = append(p.nested, entry)
+
+	field.Label = descriptorpb.FieldDescriptorProto_LABEL_REPEATED.Enum()
+	field.Type = descriptorpb.FieldDescriptorProto_TYPE_MESSAGE.Enum()
+	field.TypeName = proto.String(p.scope + "." + entryName)
+}
+
+// mapEntryName is the name protoc gives the entry message of a map field,
+// e.g. FooBarEntry for foo_bar.
+func mapEntryName(field string) string {
+	var b strings.Builder
+	upper := true
+	for i := 0; i < len(field); i++ {
+		c := field[i]
+		switch {
+		case c == '_':
+			upper = true
+		case upper:
+			b.WriteString(strings.ToUpper(string(c)))
+			upper = false
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return b.String() + "Entry"
+}
+
+func (b *fileBuilder) service(names map[string]bool) *descriptorpb.ServiceDescriptorProto {
+	service := &descriptorpb.ServiceDescriptorProto{Name: proto.String(b.unique(typeNames, names))}
+	methods := make(map[string]bool)
+	for i := 0; i < 1+b.r.intn(3); i++ {
+		service.Method = append(service.Method, &descriptorpb.MethodDescriptorProto{
+			Name:            proto.String(b.unique(typeNames, methods)),
+			InputType:       proto.String(b.messages[b.r.intn(len(b.messages))]),
+			OutputType:      proto.String(b.messages[b.r.intn(len(b.messages))]),
+			ClientStreaming: proto.Bool(b.r.bool()),
+			ServerStreaming: proto.Bool(b.r.bool()),
+		})
+	}
+	return service
+}
@@ -0,0 +1,242 @@
+package rbi_generator
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/coinbase/protoc-gen-rbi/ruby_types"
+
+	pgs "github.com/lyft/protoc-gen-star"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+// Generate runs the module on an in-memory request, e.g. built from
+// descriptors a Go build tool parsed itself, instead of protoc's stdin and
+// stdout. Generation errors are reported by the response's Error, like they
+// are to protoc, and failures that would make the plugin exit, like a request
+// pgs can't parse or a template that fails to render, are returned.
+func Generate(req *pluginpb.CodeGeneratorRequest, options Options) (res *pluginpb.CodeGeneratorResponse, err error) {
+	req = proto.Clone(req).(*pluginpb.CodeGeneratorRequest)
+	rewriteGroups(req)
+	if errs := stripBadOptions(req); len(errs) > 0 {
+		res := &pluginpb.CodeGeneratorResponse{Error: proto.String(joinErrors(errs))}
+		advertiseFeatures(res)
+		return res, nil
+	}
+	in, err := proto.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			f, ok := r.(failure)
+			if !ok {
+				panic(r)
+			}
+			res, err = nil, f
+		}
+	}()
+
+	// pgs's persister exits on failures with the Debugger Init gives it, so
+	// the module's artifacts are turned into the response here instead of by
+	// Render
+	d := failDebugger{}
+	gen := pgs.Init(pgs.ProtocInput(bytes.NewReader(in)))
+	gen.Debugger = d
+	ast := gen.AST()
+
+	params := pgs.ParseParameters(req.GetParameter())
+	m := New(options)
+	m.InitContext(pgs.Context(d.Push(m.Name()), params, params.OutputPath()))
+	return response(m.Execute(ast.Targets(), ast.Packages()))
+}
+
+// response builds the response like pgs's persister, for the artifacts the
+// module adds.
+func response(artifacts []pgs.Artifact) (*pluginpb.CodeGeneratorResponse, error) {
+	res := &pluginpb.CodeGeneratorResponse{}
+	advertiseFeatures(res)
+	format := RubyFormat()
+	errs := make([]string, 0)
+	for _, artifact := range artifacts {
+		var name string
+		var file *pluginpb.CodeGeneratorResponse_File
+		var err error
+		switch a := artifact.(type) {
+		case pgs.GeneratorFile:
+			// validated files, rendered already
+			name = a.Name
+			file, err = a.ProtoFile()
+		case pgs.GeneratorTemplateFile:
+			name = a.Name
+			file, err = a.ProtoFile()
+		case pgs.GeneratorError:
+			errs = append(errs, a.Message)
+			continue
+		default:
+			return nil, fmt.Errorf("unexpected artifact %T", a)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", name, err)
+		}
+		if format.Match(artifact) {
+			content, err := format.Process([]byte(file.GetContent()))
+			if err != nil {
+				return nil, err
+			}
+			file.Content = proto.String(string(content))
+		}
+		res.File = append(res.File, file)
+	}
+	if len(errs) > 0 {
+		res.Error = proto.String(strings.Join(errs, "; "))
+	}
+	return res, nil
+}
+
+// failure is a failure of pgs or the module, which Generate returns.
+type failure string
+
+func (f failure) Error() string { return string(f) }
+
+// failDebugger is the Debugger of Generate: it logs like pgs's, but panics
+// with the failure instead of exiting.
+type failDebugger struct {
+	prefix string
+}
+
+func (d failDebugger) Log(v ...interface{}) {
+	fmt.Fprintln(os.Stderr, append([]interface{}{d.prefix}, v...)...)
+}
+
+func (d failDebugger) Logf(format string, v ...interface{}) {
+	if d.prefix != "" && !strings.HasPrefix(format, "[") {
+		format = " " + format
+	}
+	fmt.Fprintln(os.Stderr, fmt.Sprintf(d.prefix+format, v...))
+}
+
+func (d failDebugger) Debug(v ...interface{})                 {}
+func (d failDebugger) Debugf(format string, v ...interface{}) {}
+
+func (d failDebugger) Fail(v ...interface{}) {
+	panic(failure(strings.TrimSpace(d.prefix + " " + fmt.Sprint(v...))))
+}
+
+func (d failDebugger) Failf(format string, v ...interface{}) { d.Fail(fmt.Sprintf(format, v...)) }
+
+func (d failDebugger) CheckErr(err error, v ...interface{}) {
+	if err != nil {
+		d.Failf("%s: %v", fmt.Sprint(v...), err)
+	}
+}
+
+func (d failDebugger) Assert(expr bool, v ...interface{}) {
+	if !expr {
+		d.Fail(v...)
+	}
+}
+
+func (d failDebugger) Exit(code int) { d.Failf("exit status %d", code) }
+
+func (d failDebugger) Push(prefix string) pgs.Debugger {
+	return failDebugger{prefix: strings.TrimSpace(fmt.Sprintf("%s [%s]", d.prefix, prefix))}
+}
+
+func (d failDebugger) Pop() pgs.Debugger { return failDebugger{} }
+
+// Input wraps protoc's request read from r for pgs.ProtocInput, rewriting it
+// like Generate does. Bad rbi options fail reading it, with their errors.
+func Input(r io.Reader) io.Reader {
+	in, err := ioutil.ReadAll(r)
+	if err != nil {
+		return errReader{err}
+	}
+	// the extensions are left undecoded, so the bad rbi options can be found
+	req := &pluginpb.CodeGeneratorRequest{}
+	if err := (proto.UnmarshalOptions{Resolver: new(protoregistry.Types)}).Unmarshal(in, req); err != nil {
+		return errReader{err}
+	}
+	rewriteGroups(req)
+	if errs := stripBadOptions(req); len(errs) > 0 {
+		return errReader{errors.New(joinErrors(errs))}
+	}
+	if in, err = proto.Marshal(req); err != nil {
+		return errReader{err}
+	}
+	return bytes.NewReader(in)
+}
+
+type errReader struct{ err error }
+
+func (r errReader) Read([]byte) (int, error) { return 0, r.err }
+
+// Output wraps protoc's response writer w for pgs.ProtocOutput, advertising the
+// editions the module supports, which pgs doesn't.
+func Output(w io.Writer) io.Writer {
+	return responseWriter{w}
+}
+
+// responseWriter expects the response in a single Write, like pgs does.
+type responseWriter struct{ w io.Writer }
+
+func (w responseWriter) Write(p []byte) (int, error) {
+	res := &pluginpb.CodeGeneratorResponse{}
+	if err := proto.Unmarshal(p, res); err != nil {
+		return 0, err
+	}
+	advertiseFeatures(res)
+	out, err := proto.Marshal(res)
+	if err != nil {
+		return 0, err
+	}
+	if _, err := w.w.Write(out); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// advertiseFeatures sets the features and editions the module supports, of
+// which pgs only knows proto3 optional fields.
+func advertiseFeatures(res *pluginpb.CodeGeneratorResponse) {
+	res.SupportedFeatures = proto.Uint64(uint64(pluginpb.CodeGeneratorResponse_FEATURE_PROTO3_OPTIONAL | pluginpb.CodeGeneratorResponse_FEATURE_SUPPORTS_EDITIONS))
+	res.MinimumEdition = proto.Int32(int32(ruby_types.MinimumEdition))
+	res.MaximumEdition = proto.Int32(int32(ruby_types.MaximumEdition))
+}
+
+// rewriteGroups turns proto2 group fields, which pgs refuses to parse, into
+// fields of their nested group message. That's how the Ruby runtime exposes
+// them too: `result` returns a `Legacy::Result`.
+func rewriteGroups(req *pluginpb.CodeGeneratorRequest) {
+	for _, file := range req.GetProtoFile() {
+		rewriteGroupFields(file.GetExtension())
+		for _, message := range file.GetMessageType() {
+			rewriteMessageGroups(message)
+		}
+	}
+}
+
+func rewriteMessageGroups(message *descriptorpb.DescriptorProto) {
+	rewriteGroupFields(message.GetField())
+	rewriteGroupFields(message.GetExtension())
+	for _, nested := range message.GetNestedType() {
+		rewriteMessageGroups(nested)
+	}
+}
+
+func rewriteGroupFields(fields []*descriptorpb.FieldDescriptorProto) {
+	for _, field := range fields {
+		if field.GetType() == descriptorpb.FieldDescriptorProto_TYPE_GROUP {
+			field.Type = descriptorpb.FieldDescriptorProto_TYPE_MESSAGE.Enum()
+		}
+	}
+}
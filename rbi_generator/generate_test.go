@@ -0,0 +1,323 @@
+package rbi_generator
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/coinbase/protoc-gen-rbi/rbi"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+// Failures that make the plugin exit are returned by Generate.
+func TestGenerateFailures(t *testing.T) {
+	templates := t.TempDir()
+	text := `{{ define "field" }}{{ .NoSuchField }}{{ end }}`
+	if err := ioutil.WriteFile(filepath.Join(templates, "field.tmpl"), []byte(text), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name   string
+		protos []string
+		param  string
+		err    string
+	}{
+		{"template", []string{"subdir/messages.proto"}, "templates_dir=" + templates, "subdir/messages_pb.rbi: template: "},
+		{"no files", nil, "", "no files to generate"},
+	}
+	files := loadDescriptorSet(t, "testdata")
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			req := &pluginpb.CodeGeneratorRequest{
+				FileToGenerate: test.protos,
+				Parameter:      proto.String(test.param),
+				ProtoFile:      files,
+			}
+			res, err := Generate(req, DefaultOptions())
+			if err == nil {
+				t.Fatalf("got no error, and the response %v", res)
+			}
+			if !strings.Contains(err.Error(), test.err) {
+				t.Errorf("got %q, want an error containing %q", err, test.err)
+			}
+		})
+	}
+}
+
+// protoc passes an empty parameter when there are no options, which generates
+// the goldens of the default run.
+func TestGenerateEmptyParameter(t *testing.T) {
+	req := &pluginpb.CodeGeneratorRequest{
+		FileToGenerate: testdataProtos(t, "testdata"),
+		Parameter:      proto.String(""),
+		ProtoFile:      loadDescriptorSet(t, "testdata"),
+	}
+	res, err := Generate(req, DefaultOptions())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Error != nil {
+		t.Fatal(res.GetError())
+	}
+	if len(res.File) == 0 {
+		t.Fatal("no files generated")
+	}
+	for _, file := range res.File {
+		golden, err := ioutil.ReadFile(filepath.Join("testdata", file.GetName()))
+		if err != nil {
+			t.Errorf("%s: %v", file.GetName(), err)
+			continue
+		}
+		if file.GetContent() != string(golden) {
+			t.Errorf("%s differs from its golden:\n%s", file.GetName(), diff(string(golden), file.GetContent()))
+		}
+	}
+}
+
+// validate=true renders each file once, so it generates the same files and
+// logs their warnings as often as without it.
+func TestGenerateValidate(t *testing.T) {
+	generate := func(param string) (*pluginpb.CodeGeneratorResponse, string) {
+		req := &pluginpb.CodeGeneratorRequest{
+			FileToGenerate: []string{"naming.proto"},
+			Parameter:      proto.String(param),
+			ProtoFile:      loadDescriptorSet(t, "testdata"),
+		}
+		var res *pluginpb.CodeGeneratorResponse
+		stderr := captureStderr(t, func() {
+			var err error
+			if res, err = Generate(req, DefaultOptions()); err != nil {
+				t.Fatal(err)
+			}
+		})
+		if res.Error != nil {
+			t.Fatal(res.GetError())
+		}
+		return res, stderr
+	}
+
+	want, wantLog := generate("")
+	got, gotLog := generate("validate=true")
+	if !strings.Contains(wantLog, "warning:") {
+		t.Fatalf("naming.proto logs no warning:\n%s", wantLog)
+	}
+	if gotLog != wantLog {
+		t.Errorf("validate=true logs\n%s\nwant\n%s", gotLog, wantLog)
+	}
+	if len(got.File) != len(want.File) {
+		t.Fatalf("validate=true generates %d files, want %d", len(got.File), len(want.File))
+	}
+	for i, file := range got.File {
+		if file.GetName() != want.File[i].GetName() || file.GetContent() != want.File[i].GetContent() {
+			t.Errorf("validate=true generates %s differently:\n%s", file.GetName(), diff(want.File[i].GetContent(), file.GetContent()))
+		}
+	}
+}
+
+// captureStderr returns what f writes to stderr.
+func captureStderr(t *testing.T, f func()) string {
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	stderr := os.Stderr
+	os.Stderr = w
+	defer func() { os.Stderr = stderr }()
+
+	out := make(chan []byte)
+	go func() {
+		data, _ := ioutil.ReadAll(r)
+		out <- data
+	}()
+	f()
+	w.Close()
+	return string(<-out)
+}
+
+// Unknown parameters are reported in the response's error.
+func TestGenerateUnknownParameter(t *testing.T) {
+	req := &pluginpb.CodeGeneratorRequest{
+		FileToGenerate: []string{"subdir/messages.proto"},
+		Parameter:      proto.String("grcp=true"),
+		ProtoFile:      loadDescriptorSet(t, "testdata"),
+	}
+	res, err := Generate(req, DefaultOptions())
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "unknown parameter grcp, did you mean grpc?"
+	if !strings.Contains(res.GetError(), want) {
+		t.Errorf("got error %q, want it to contain %q", res.GetError(), want)
+	}
+}
+
+// Bad values are reported with the option, config file or parameter they're
+// set by.
+func TestGenerateBadValues(t *testing.T) {
+	config := filepath.Join(t.TempDir(), "config.json")
+	if err := ioutil.WriteFile(config, []byte(`{"header_file": "missing.txt"}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		options func(*Options)
+		param   string
+		err     string
+	}{
+		{"option", func(o *Options) { o.TemplatesDir = "missing" }, "", "bad option TemplatesDir: no *.tmpl files in missing"},
+		{"option globs", func(o *Options) { o.ExcludeNames = []string{""} }, "", "bad option ExcludeNames: empty glob"},
+		{"config", func(o *Options) {}, "config=" + config, "config " + config + ": header_file: open missing.txt: "},
+		{"config from option", func(o *Options) { o.Config = config }, "", "config " + config + ": header_file: open missing.txt: "},
+		{"parameter", func(o *Options) { o.TemplatesDir = "testdata/templates" }, "templates_dir=missing", "bad parameter templates_dir: no *.tmpl files in missing"},
+		{"parameter over config", func(o *Options) {}, "config=" + config + ",header_file=missing.txt", "bad parameter header_file: open missing.txt: "},
+		{"parameter prefix", func(o *Options) {}, "ruby_namespace_prefix=acme", `bad parameter ruby_namespace_prefix: invalid Ruby namespace "acme"`},
+	}
+	files := loadDescriptorSet(t, "testdata")
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			options := DefaultOptions()
+			test.options(&options)
+			req := &pluginpb.CodeGeneratorRequest{
+				FileToGenerate: []string{"subdir/messages.proto"},
+				Parameter:      proto.String(test.param),
+				ProtoFile:      files,
+			}
+			res, err := Generate(req, options)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !strings.HasPrefix(res.GetError(), test.err) {
+				t.Errorf("got error %q, want it to start with %q", res.GetError(), test.err)
+			}
+		})
+	}
+}
+
+// legacyFile is legacy.proto, with the encoded rbi options of its fields, nil
+// for none:
+//
+//	syntax = "proto3";
+//
+//	package legacy;
+//
+//	message Foo {
+//	  string bar = 1 [(rbi.field) = ...];
+//	  int32 baz = 2 [(rbi.field) = ...];
+//	  string created_at = 3 [(rbi.field).type = "Time"];
+//	}
+func legacyFile(options ...[]byte) *descriptorpb.FileDescriptorProto {
+	file := &descriptorpb.FileDescriptorProto{
+		Name:    proto.String("legacy.proto"),
+		Package: proto.String("legacy"),
+		Syntax:  proto.String("proto3"),
+		MessageType: []*descriptorpb.DescriptorProto{{
+			Name: proto.String("Foo"),
+			Field: []*descriptorpb.FieldDescriptorProto{
+				{Name: proto.String("bar"), Number: proto.Int32(1), Type: descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum()},
+				{Name: proto.String("baz"), Number: proto.Int32(2), Type: descriptorpb.FieldDescriptorProto_TYPE_INT32.Enum()},
+				{Name: proto.String("created_at"), Number: proto.Int32(3), Type: descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum()},
+			},
+		}},
+		SourceCodeInfo: &descriptorpb.SourceCodeInfo{Location: []*descriptorpb.SourceCodeInfo_Location{
+			{Path: []int32{}, Span: []int32{0, 0, 9, 1}},
+			{Path: []int32{4, 0}, Span: []int32{4, 0, 8, 1}},
+			{Path: []int32{4, 0, 2, 0}, Span: []int32{5, 2, 39}},
+			{Path: []int32{4, 0, 2, 1}, Span: []int32{6, 2, 38}},
+			{Path: []int32{4, 0, 2, 2}, Span: []int32{7, 2, 53}},
+		}},
+	}
+	for i, option := range options {
+		if option == nil {
+			continue
+		}
+		field := file.MessageType[0].Field[i]
+		field.Options = &descriptorpb.FieldOptions{}
+		b := protowire.AppendTag(nil, rbi.E_Field.TypeDescriptor().Number(), protowire.BytesType)
+		field.Options.ProtoReflect().SetUnknown(protowire.AppendBytes(b, option))
+	}
+	return file
+}
+
+var (
+	// a `type` of 5 bytes, truncated
+	truncatedOption = []byte{0x0a, 0x05, 'T'}
+	// a `type` that isn't UTF-8
+	invalidOption = []byte{0x0a, 0x02, 0xff, 0xfe}
+	timeOption    = []byte{0x0a, 0x04, 'T', 'i', 'm', 'e'}
+)
+
+// Options that don't decode as rbi's are reported at their fields, all at once.
+func TestGenerateBadOptions(t *testing.T) {
+	req := &pluginpb.CodeGeneratorRequest{
+		FileToGenerate: []string{"legacy.proto"},
+		ProtoFile:      []*descriptorpb.FileDescriptorProto{legacyFile(truncatedOption, invalidOption, timeOption)},
+	}
+	res, err := Generate(req, DefaultOptions())
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "legacy.proto:6:3: message legacy.Foo, field bar: bad rbi options: not an encoded rbi.FieldOptions\n" +
+		"legacy.proto:7:3: message legacy.Foo, field baz: bad rbi options: not an encoded rbi.FieldOptions"
+	if res.GetError() != want {
+		t.Errorf("got error:\n%s\nwant:\n%s", res.GetError(), want)
+	}
+	if len(res.File) > 0 {
+		t.Errorf("got %d files, want none", len(res.File))
+	}
+
+	// the plugin fails reading the same request from protoc
+	in, err := proto.Marshal(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ioutil.ReadAll(Input(bytes.NewReader(in))); err == nil || err.Error() != want {
+		t.Errorf("got %v reading the request, want:\n%s", err, want)
+	}
+}
+
+// Bad options of the imported files aren't reported, they're dropped so the
+// request can be parsed.
+func TestGenerateBadOptionsInImports(t *testing.T) {
+	legacy := legacyFile(truncatedOption, nil, timeOption)
+	user := &descriptorpb.FileDescriptorProto{
+		Name:       proto.String("user.proto"),
+		Package:    proto.String("legacy"),
+		Syntax:     proto.String("proto3"),
+		Dependency: []string{"legacy.proto"},
+		MessageType: []*descriptorpb.DescriptorProto{{
+			Name: proto.String("User"),
+			Field: []*descriptorpb.FieldDescriptorProto{
+				{Name: proto.String("foo"), Number: proto.Int32(1), Type: descriptorpb.FieldDescriptorProto_TYPE_MESSAGE.Enum(), TypeName: proto.String(".legacy.Foo")},
+			},
+		}},
+	}
+	req := &pluginpb.CodeGeneratorRequest{
+		FileToGenerate: []string{"user.proto"},
+		Parameter:      proto.String("include_imports=true"),
+		ProtoFile:      []*descriptorpb.FileDescriptorProto{legacy, user},
+	}
+	res, err := Generate(req, DefaultOptions())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Error != nil {
+		t.Fatal(res.GetError())
+	}
+	for _, file := range res.File {
+		if file.GetName() == "legacy_pb.rbi" && !strings.Contains(file.GetContent(), "def created_at=(value)") {
+			t.Errorf("legacy_pb.rbi has no created_at setter:\n%s", file.GetContent())
+		}
+	}
+	if len(res.File) != 2 {
+		t.Errorf("got %d files, want legacy_pb.rbi and user_pb.rbi", len(res.File))
+	}
+}
@@ -0,0 +1,249 @@
+package rbi_generator
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+var update = flag.Bool("update", false, "update the golden files")
+
+type goldenRun struct {
+	dir   string
+	param string
+}
+
+// goldenRuns mirror the protoc runs of `make test`, by the directory of
+// testdata their files are in.
+var goldenRuns = []goldenRun{
+	{"", "grpc=true"},
+	{"hide_common_methods", "hide_common_methods=true"},
+	{"use_abstract_message", "use_abstract_message=true"},
+	{"all", "grpc=true,hide_common_methods=true,use_abstract_message=true,strict_enum_getters=true"},
+	{"ruby_namespace", "ruby_namespace=example=Acme::Example;testdata.subdir=Acme::Proto,ruby_namespace_prefix=Vendor"},
+	{"filter", "include_packages=example;testdata;testdata.**,exclude_files=broken_*.proto,exclude_names=example.Response;testdata.SimpleMathematics;testdata.subdir.IntegerMessage"},
+	{"custom_templates", "templates_dir=testdata/templates"},
+	{"header", "typed=true,frozen_string_literal=true,header_parameters=true,header_file=testdata/license_header.txt"},
+	{"include_imports", "include_imports=true,exclude_wkt_imports=true"},
+	{"extensions", "extensions=true"},
+	{"config", "config=testdata/config.json,grpc=true"},
+}
+
+// editionsRuns generate the protos of testdata/editions, which have their own
+// descriptor set since the protoc of grpc-tools doesn't support editions.
+var editionsRuns = []goldenRun{
+	{"editions", ""},
+}
+
+func TestMain(m *testing.M) {
+	flag.Parse()
+	// the parameters' paths are relative to the repository root, like in `make
+	// test`. It's found from this file rather than the working directory, which
+	// fuzzing workers inherit already changed.
+	_, file, _, _ := runtime.Caller(0)
+	if err := os.Chdir(filepath.Dir(filepath.Dir(file))); err != nil {
+		panic(err)
+	}
+	os.Exit(m.Run())
+}
+
+// TestGolden generates the testdata protos from testdata/descriptor_set.pb,
+// which `make descriptor_set` updates after they're changed, and compares the
+// files to the goldens, which must be all the rbi files of the run's directory.
+// `go test ./rbi_generator -update` updates them, deleting those that aren't
+// generated anymore.
+func TestGolden(t *testing.T) {
+	testGolden(t, "testdata", goldenRuns)
+}
+
+func TestGoldenEditions(t *testing.T) {
+	testGolden(t, filepath.Join("testdata", "editions"), editionsRuns)
+}
+
+func testGolden(t *testing.T, dir string, runs []goldenRun) {
+	files := loadDescriptorSet(t, dir)
+	protos := testdataProtos(t, dir)
+
+	runDirs := make(map[string]bool)
+	for _, run := range runs {
+		runDirs[filepath.Join("testdata", run.dir)] = true
+	}
+
+	for _, run := range runs {
+		run := run
+		name := run.dir
+		if name == "" {
+			name = "testdata"
+		}
+		t.Run(name, func(t *testing.T) {
+			root := filepath.Join("testdata", run.dir)
+			orphans := goldenFiles(t, root, runDirs)
+			req := &pluginpb.CodeGeneratorRequest{
+				FileToGenerate: protos,
+				Parameter:      proto.String(run.param),
+				ProtoFile:      files,
+			}
+			res, err := Generate(req, DefaultOptions())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if res.Error != nil {
+				t.Fatal(res.GetError())
+			}
+			if res.GetSupportedFeatures()&uint64(pluginpb.CodeGeneratorResponse_FEATURE_SUPPORTS_EDITIONS) == 0 {
+				t.Error("editions support isn't advertised")
+			}
+
+			for _, file := range res.File {
+				delete(orphans, file.GetName())
+				if err := checkRBI([]byte(file.GetContent())); err != nil {
+					t.Errorf("%s:%v", file.GetName(), err)
+				}
+
+				path := filepath.Join(root, file.GetName())
+				if *update {
+					if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+						t.Fatal(err)
+					}
+					if err := ioutil.WriteFile(path, []byte(file.GetContent()), 0644); err != nil {
+						t.Fatal(err)
+					}
+					continue
+				}
+
+				golden, err := ioutil.ReadFile(path)
+				if err != nil {
+					t.Errorf("%s: %v", file.GetName(), err)
+					continue
+				}
+				if file.GetContent() != string(golden) {
+					t.Errorf("%s differs from %s:\n%s", file.GetName(), path, diff(string(golden), file.GetContent()))
+				}
+			}
+
+			for _, name := range sortedKeys(orphans) {
+				path := filepath.Join(root, name)
+				if *update {
+					if err := os.Remove(path); err != nil {
+						t.Fatal(err)
+					}
+					continue
+				}
+				t.Errorf("%s isn't generated anymore", path)
+			}
+		})
+	}
+}
+
+// loadDescriptorSet loads the descriptor_set.pb of the directory.
+func loadDescriptorSet(t testing.TB, dir string) []*descriptorpb.FileDescriptorProto {
+	data, err := ioutil.ReadFile(filepath.Join(dir, "descriptor_set.pb"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	set := &descriptorpb.FileDescriptorSet{}
+	if err := proto.Unmarshal(data, set); err != nil {
+		t.Fatal(err)
+	}
+	return set.File
+}
+
+// goldenFiles returns the rbi files under root by their path relative to it,
+// leaving out the directories of other runs and those with their own
+// descriptor set.
+func goldenFiles(t *testing.T, root string, runDirs map[string]bool) map[string]bool {
+	files := make(map[string]bool)
+	err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() && path != root {
+			if runDirs[path] {
+				return filepath.SkipDir
+			}
+			if _, err := os.Stat(filepath.Join(path, "descriptor_set.pb")); err == nil {
+				return filepath.SkipDir
+			}
+		}
+		if !info.IsDir() && strings.HasSuffix(path, ".rbi") {
+			rel, err := filepath.Rel(root, path)
+			if err != nil {
+				return err
+			}
+			files[filepath.ToSlash(rel)] = true
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return files
+}
+
+func sortedKeys(set map[string]bool) []string {
+	keys := make([]string, 0, len(set))
+	for key := range set {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// testdataProtos returns the paths of the protos in dir relative to it, like
+// the PROTOS of `make test`. Directories with their own descriptor set are
+// left out.
+func testdataProtos(t *testing.T, dir string) []string {
+	protos := make([]string, 0)
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() && path != dir {
+			if _, err := os.Stat(filepath.Join(path, "descriptor_set.pb")); err == nil {
+				return filepath.SkipDir
+			}
+		}
+		if !info.IsDir() && strings.HasSuffix(path, ".proto") {
+			rel, err := filepath.Rel(dir, path)
+			if err != nil {
+				return err
+			}
+			protos = append(protos, filepath.ToSlash(rel))
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sort.Strings(protos)
+	return protos
+}
+
+// diff returns the first differing line of the golden and generated files.
+func diff(golden, generated string) string {
+	goldenLines := strings.Split(golden, "\n")
+	generatedLines := strings.Split(generated, "\n")
+	for i := 0; i < len(goldenLines) || i < len(generatedLines); i++ {
+		var want, got string
+		if i < len(goldenLines) {
+			want = goldenLines[i]
+		}
+		if i < len(generatedLines) {
+			got = generatedLines[i]
+		}
+		if want != got {
+			return fmt.Sprintf("line %d:\n-%s\n+%s", i+1, want, got)
+		}
+	}
+	return ""
+}
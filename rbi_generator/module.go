@@ -0,0 +1,696 @@
+package rbi_generator
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/coinbase/protoc-gen-rbi/ruby_types"
+
+	pgs "github.com/lyft/protoc-gen-star"
+	pgsgo "github.com/lyft/protoc-gen-star/lang/go"
+)
+
+var (
+	validRubyField     = regexp.MustCompile(`\A[a-z][A-Za-z0-9_]*\z`)
+	validRubyNamespace = regexp.MustCompile(`\A[A-Z][A-Za-z0-9_]*(::[A-Z][A-Za-z0-9_]*)*\z`)
+)
+
+// Options are the generator's options. The config file and the parameters
+// passed by protoc take precedence over them.
+type Options struct {
+	// generate _services_pb.rbi files for gRPC services
+	GRPC bool
+	// omit the decode, encode and descriptor methods every message has
+	HideCommonMethods bool
+	// make messages inherit from Google::Protobuf::AbstractMessage
+	UseAbstractMessage bool
+	// type proto3 enum getters as Symbol rather than T.any(Symbol, Integer)
+	StrictEnumGetters bool
+	// reference Ruby core classes from the top level, e.g. ::String
+	QualifyCoreTypes bool
+	// declare typed helpers for the extensions defined by the files, which the
+	// runtime doesn't define
+	Extensions bool
+	// Ruby namespaces of proto packages and the packages nested in them
+	RubyNamespace map[string]string
+	// Ruby namespace to nest the other packages in
+	RubyNamespacePrefix string
+	// globs selecting the files, packages and names to generate
+	IncludeFiles    []string
+	ExcludeFiles    []string
+	IncludePackages []string
+	ExcludePackages []string
+	IncludeNames    []string
+	ExcludeNames    []string
+	// directory of *.tmpl files redefining blocks of the default templates
+	TemplatesDir string
+	// also generate the files imported by the targets
+	IncludeImports bool
+	// don't generate the imported google.protobuf files, which the
+	// google-protobuf gem's RBI covers
+	ExcludeWKTImports bool
+	// check the syntax of the generated files, failing on invalid Ruby
+	Validate bool
+	// strictness of the `# typed:` sigil, or empty to omit it
+	Typed string
+	// add the `# frozen_string_literal: true` magic comment
+	FrozenStringLiteral bool
+	// write the effective parameters in the header, to detect stale files
+	HeaderParameters bool
+	// file of comments, e.g. a license, written at the top of every file
+	HeaderFile string
+	// JSON config file
+	Config string
+}
+
+// DefaultOptions returns the options protoc-gen-rbi runs with.
+func DefaultOptions() Options {
+	return Options{
+		GRPC:             true,
+		QualifyCoreTypes: true,
+		Typed:            "strict",
+	}
+}
+
+// Module is the protoc-gen-star module generating .rbi files.
+type Module struct {
+	*pgs.ModuleBase
+	ctx     pgsgo.Context
+	options Options
+	// the config file, empty if the config parameter isn't set
+	config     *config
+	configPath string
+	// options set on the command line, taking precedence over the config file
+	params          fileOptions
+	namespaces      map[string]string
+	namespacePrefix string
+	filter          filter
+	// the globs of the filter by parameter, and the templates_dir and
+	// header_file, listed in the header parameters
+	globs        map[string][]string
+	templatesDir string
+	headerFile   string
+	// templates redefining blocks of the default templates
+	templateOverrides []templateOverride
+	generators        map[settings]*generator
+	// comments written at the top of every file
+	header            string
+	includeImports    bool
+	excludeWKTImports bool
+	validate          bool
+	// print the supported parameters, or the version, instead of generating
+	help    bool
+	version bool
+	// bad parameters, reported by Execute
+	errors []error
+}
+
+type templateOverride struct {
+	name string
+	text string
+}
+
+// generator renders the files sharing the same settings.
+type generator struct {
+	settings
+	types      ruby_types.TypeMapper
+	tpl        *template.Template
+	serviceTpl *template.Template
+}
+
+// New returns the module, to be registered with pgs.Generator.RegisterModule.
+func New(options Options) *Module {
+	return &Module{ModuleBase: &pgs.ModuleBase{}, options: options}
+}
+
+func (m *Module) InitContext(c pgs.BuildContext) {
+	m.ModuleBase.InitContext(c)
+	m.ctx = pgsgo.InitContext(c.Parameters())
+	m.generators = make(map[settings]*generator)
+	m.errors = validateParams(m.ctx.Params())
+	if help := m.boolParam("help"); help != nil {
+		m.help = *help
+	}
+	if version := m.boolParam("version"); version != nil {
+		m.version = *version
+	}
+
+	m.config = &config{}
+	m.configPath = m.ctx.Params().StrDefault("config", m.options.Config)
+	if m.configPath != "" {
+		cfg, err := loadConfig(m.configPath)
+		if err != nil {
+			m.errors = append(m.errors, err)
+		} else {
+			m.config = cfg
+		}
+	}
+
+	m.params = fileOptions{
+		GRPC:                m.boolParam("grpc"),
+		HideCommonMethods:   m.boolParam("hide_common_methods"),
+		UseAbstractMessage:  m.boolParam("use_abstract_message"),
+		StrictEnumGetters:   m.boolParam("strict_enum_getters"),
+		QualifyCoreTypes:    m.boolParam("qualify_core_types"),
+		Typed:               m.stringParam("typed"),
+		FrozenStringLiteral: m.boolParam("frozen_string_literal"),
+		HeaderParameters:    m.boolParam("header_parameters"),
+		Extensions:          m.boolParam("extensions"),
+	}
+	if !validSigil(m.options.Typed) {
+		m.errors = append(m.errors, fmt.Errorf("bad option Typed: %s", sigilError(m.options.Typed)))
+	}
+	if m.params.Typed != nil && !validSigil(*m.params.Typed) {
+		m.errors = append(m.errors, fmt.Errorf("bad parameter typed: %s", sigilError(*m.params.Typed)))
+	}
+
+	m.namespaces = make(map[string]string)
+	for pkg, namespace := range m.options.RubyNamespace {
+		if !validRubyNamespace.MatchString(namespace) {
+			m.errors = append(m.errors, fmt.Errorf("bad option RubyNamespace: invalid Ruby namespace %q for package %s", namespace, pkg))
+		}
+		m.namespaces[pkg] = namespace
+	}
+	for pkg, namespace := range m.config.RubyNamespace {
+		m.namespaces[pkg] = namespace
+	}
+	namespaces, err := parseRubyNamespaces(m.ctx.Params().Str("ruby_namespace"))
+	if err != nil {
+		m.errors = append(m.errors, fmt.Errorf("bad parameter ruby_namespace: %v", err))
+	}
+	for pkg, namespace := range namespaces {
+		m.namespaces[pkg] = namespace
+	}
+
+	var source valueSource
+	m.namespacePrefix, source = m.stringValue("ruby_namespace_prefix", m.options.RubyNamespacePrefix, m.config.RubyNamespacePrefix)
+	if m.namespacePrefix != "" && !validRubyNamespace.MatchString(m.namespacePrefix) {
+		m.errors = append(m.errors, m.badValue(source, "ruby_namespace_prefix", "RubyNamespacePrefix", fmt.Errorf("invalid Ruby namespace %q", m.namespacePrefix)))
+	}
+
+	m.globs = make(map[string][]string)
+	m.filter = filter{
+		includeFiles:    m.globsParam("include_files", "IncludeFiles", pathSeparator, m.options.IncludeFiles, m.config.IncludeFiles),
+		excludeFiles:    m.globsParam("exclude_files", "ExcludeFiles", pathSeparator, m.options.ExcludeFiles, m.config.ExcludeFiles),
+		includePackages: m.globsParam("include_packages", "IncludePackages", nameSeparator, m.options.IncludePackages, m.config.IncludePackages),
+		excludePackages: m.globsParam("exclude_packages", "ExcludePackages", nameSeparator, m.options.ExcludePackages, m.config.ExcludePackages),
+		includeNames:    m.globsParam("include_names", "IncludeNames", nameSeparator, m.options.IncludeNames, m.config.IncludeNames),
+		excludeNames:    m.globsParam("exclude_names", "ExcludeNames", nameSeparator, m.options.ExcludeNames, m.config.ExcludeNames),
+	}
+
+	m.includeImports = m.globalBoolParam("include_imports", m.options.IncludeImports, m.config.IncludeImports)
+	m.excludeWKTImports = m.globalBoolParam("exclude_wkt_imports", m.options.ExcludeWKTImports, m.config.ExcludeWKTImports)
+	m.validate = m.globalBoolParam("validate", m.options.Validate, m.config.Validate)
+
+	m.headerFile, source = m.stringValue("header_file", m.options.HeaderFile, m.config.HeaderFile)
+	if m.headerFile != "" {
+		header, err := loadHeader(m.headerFile)
+		if err != nil {
+			m.errors = append(m.errors, m.badValue(source, "header_file", "HeaderFile", err))
+		}
+		m.header = header
+	}
+
+	m.templatesDir, source = m.stringValue("templates_dir", m.options.TemplatesDir, m.config.TemplatesDir)
+	if m.templatesDir != "" {
+		overrides, err := loadTemplateOverrides(m.templatesDir)
+		if err == nil {
+			m.templateOverrides = overrides
+			_, err = m.parseTemplates(m.funcs(&generator{}))
+		}
+		if err != nil {
+			m.errors = append(m.errors, m.badValue(source, "templates_dir", "TemplatesDir", err))
+		}
+	}
+}
+
+// loadHeader reads the comments of the header file. Lines that aren't
+// comments would be code preceding the magic comments, so they're rejected.
+func loadHeader(path string) (string, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	lines := strings.Split(strings.TrimRight(string(data), "\n"), "\n")
+	for i, line := range lines {
+		if line = strings.TrimSpace(line); line != "" && !strings.HasPrefix(line, "#") {
+			return "", fmt.Errorf("%s:%d: not a comment", path, i+1)
+		}
+	}
+	return strings.Join(lines, "\n") + "\n", nil
+}
+
+// loadTemplateOverrides reads the *.tmpl files of dir, in name order.
+func loadTemplateOverrides(dir string) ([]templateOverride, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.tmpl"))
+	if err != nil {
+		return nil, err
+	}
+	if len(paths) == 0 {
+		return nil, fmt.Errorf("no *.tmpl files in %s", dir)
+	}
+	overrides := make([]templateOverride, 0, len(paths))
+	for _, path := range paths {
+		text, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		overrides = append(overrides, templateOverride{name: path, text: string(text)})
+	}
+	return overrides, nil
+}
+
+// boolParam returns the boolean parameter, or nil if it isn't set.
+func (m *Module) boolParam(name string) *bool {
+	if _, ok := m.ctx.Params()[name]; !ok {
+		return nil
+	}
+	value, err := m.ctx.Params().Bool(name)
+	if err != nil {
+		m.errors = append(m.errors, fmt.Errorf("bad parameter %s: %q is not a boolean", name, m.ctx.Params().Str(name)))
+		return nil
+	}
+	return &value
+}
+
+// globalBoolParam returns the boolean parameter, or if it isn't set the
+// config file's value, or the option.
+func (m *Module) globalBoolParam(name string, option bool, config *bool) bool {
+	if param := m.boolParam(name); param != nil {
+		return *param
+	}
+	if config != nil {
+		return *config
+	}
+	return option
+}
+
+// valueSource is where the value of a setting comes from.
+type valueSource int
+
+const (
+	fromOptions valueSource = iota
+	fromConfig
+	fromParameters
+)
+
+// badValue returns the error of a bad value, prefixed with where it's set so
+// it's fixed there.
+func (m *Module) badValue(source valueSource, name, option string, err error) error {
+	switch source {
+	case fromParameters:
+		return fmt.Errorf("bad parameter %s: %v", name, err)
+	case fromConfig:
+		return fmt.Errorf("config %s: %s: %v", m.configPath, name, err)
+	}
+	return fmt.Errorf("bad option %s: %v", option, err)
+}
+
+// stringValue returns the parameter, or if it isn't set the config file's
+// value, or the option, and where it comes from.
+func (m *Module) stringValue(name, option string, config *string) (string, valueSource) {
+	if param := m.stringParam(name); param != nil {
+		return *param, fromParameters
+	}
+	if config != nil {
+		return *config, fromConfig
+	}
+	return option, fromOptions
+}
+
+// stringParam returns the parameter, or nil if it isn't set.
+func (m *Module) stringParam(name string) *string {
+	if value, ok := m.ctx.Params()[name]; ok {
+		return &value
+	}
+	return nil
+}
+
+// globsParam returns the `;` separated globs of the parameter, or if it isn't
+// set those of the config file, or the options.
+func (m *Module) globsParam(name, option string, separator byte, options, config []string) []*regexp.Regexp {
+	globs, source := options, fromOptions
+	if config != nil {
+		globs, source = config, fromConfig
+	}
+	if param, ok := m.ctx.Params()[name]; ok {
+		globs, source = nil, fromParameters
+		if param != "" {
+			globs = strings.Split(param, ";")
+		}
+	}
+	m.globs[name] = globs
+	res, err := compileGlobs(globs, separator)
+	if err != nil {
+		m.errors = append(m.errors, m.badValue(source, name, option, err))
+	}
+	return res
+}
+
+// parseRubyNamespaces parses `;` separated package=Namespace mappings, e.g.
+// `acme.billing=Acme::Billing::Proto;acme.ledger=Ledger`
+func parseRubyNamespaces(param string) (map[string]string, error) {
+	namespaces := make(map[string]string)
+	if param == "" {
+		return namespaces, nil
+	}
+	for _, mapping := range strings.Split(param, ";") {
+		i := strings.Index(mapping, "=")
+		if i <= 0 {
+			return nil, fmt.Errorf("expected package=Namespace, got %q", mapping)
+		}
+		pkg, namespace := mapping[:i], mapping[i+1:]
+		if !validRubyNamespace.MatchString(namespace) {
+			return nil, fmt.Errorf("invalid Ruby namespace %q for package %s", namespace, pkg)
+		}
+		namespaces[pkg] = namespace
+	}
+	return namespaces, nil
+}
+
+// generatorFor returns the generator for the file's settings, creating it on
+// first use.
+func (m *Module) generatorFor(file pgs.File) *generator {
+	s := m.settingsFor(file)
+	if g, ok := m.generators[s]; ok {
+		return g
+	}
+
+	g := &generator{
+		settings: s,
+		types: ruby_types.TypeMapper{
+			StrictEnumGetters: s.strictEnumGetters,
+			QualifyCoreTypes:  s.qualifyCoreTypes,
+			Namespaces:        m.namespaces,
+			NamespacePrefix:   m.namespacePrefix,
+		},
+	}
+
+	// the templates are checked by InitContext
+	t := template.Must(m.parseTemplates(m.funcs(g)))
+	g.tpl = t.Lookup("file")
+	g.serviceTpl = t.Lookup("services")
+	m.generators[s] = g
+	return g
+}
+
+// funcs are the functions available to the templates.
+func (m *Module) funcs(g *generator) template.FuncMap {
+	return template.FuncMap{
+		"initializerHashParam":     m.initializerHashParam,
+		"optional":                 m.optional,
+		"optionalOneOf":            m.optionalOneOf,
+		"willGenerateInvalidRuby":  m.willGenerateInvalidRuby,
+		"messages":                 m.messages,
+		"enums":                    m.enums,
+		"definedExtensions":        m.extensions,
+		"fullName":                 fullName,
+		"services":                 m.services,
+		"fields":                   ruby_types.Fields,
+		"enumValues":               m.enumValues,
+		"rubyPackage":              g.types.RubyPackage,
+		"rubyNamespaces":           g.types.RubyNamespaces,
+		"rubyServiceNamespaces":    g.types.RubyServiceNamespaces,
+		"rubyMessageType":          g.types.RubyMessageType,
+		"rubyServiceModule":        g.types.RubyServiceModule,
+		"rubyExtensionModule":      g.types.RubyExtensionModule,
+		"rubyGetterFieldType":      g.types.RubyGetterFieldType,
+		"rubySetterFieldType":      g.types.RubySetterFieldType,
+		"rubyInitializerFieldType": g.types.RubyInitializerFieldType,
+		"rubyFieldValue":           g.types.RubyFieldValue,
+		"isWrapperField":           ruby_types.IsWrapperField,
+		"rubyWrapperGetterType":    g.types.RubyWrapperGetterType,
+		"rubyWrapperSetterType":    g.types.RubyWrapperSetterType,
+		"coreType":                 g.types.CoreType,
+		"rubyMethodParamType":      g.types.RubyMethodParamType,
+		"rubyMethodReturnType":     g.types.RubyMethodReturnType,
+		"hideCommonMethods":        func() bool { return g.hideCommonMethods },
+		"useAbstractMessage":       func() bool { return g.useAbstractMessage },
+		"extensions":               func() bool { return g.extensions },
+		"version":                  func() string { return Version },
+		"header":                   func() string { return m.header },
+		"typed":                    func() string { return g.typed },
+		"frozenStringLiteral":      func() bool { return g.frozenStringLiteral },
+		"headerParameters": func() string {
+			if !g.headerParameters {
+				return ""
+			}
+			return m.effectiveParameters(g)
+		},
+	}
+}
+
+// parseTemplates parses the default templates, then the overrides from the
+// templates_dir.
+func (m *Module) parseTemplates(funcs template.FuncMap) (*template.Template, error) {
+	t, err := template.New("rbi").Funcs(funcs).Parse(templates)
+	if err != nil {
+		return nil, err
+	}
+	for _, override := range m.templateOverrides {
+		if _, err := t.New(override.name).Parse(override.text); err != nil {
+			return nil, err
+		}
+	}
+	return t, nil
+}
+
+// effectiveParameters lists the parameters the generator's files are rendered
+// with, including those from the options and config file. Any of them changing
+// changes the files.
+func (m *Module) effectiveParameters(g *generator) string {
+	params := []string{
+		fmt.Sprintf("grpc=%t", g.grpc),
+		fmt.Sprintf("hide_common_methods=%t", g.hideCommonMethods),
+		fmt.Sprintf("use_abstract_message=%t", g.useAbstractMessage),
+		fmt.Sprintf("strict_enum_getters=%t", g.strictEnumGetters),
+		fmt.Sprintf("qualify_core_types=%t", g.qualifyCoreTypes),
+		fmt.Sprintf("typed=%s", g.typed),
+		fmt.Sprintf("frozen_string_literal=%t", g.frozenStringLiteral),
+		fmt.Sprintf("extensions=%t", g.extensions),
+		fmt.Sprintf("include_imports=%t", m.includeImports),
+		fmt.Sprintf("exclude_wkt_imports=%t", m.excludeWKTImports),
+		fmt.Sprintf("validate=%t", m.validate),
+	}
+	if len(m.namespaces) > 0 {
+		namespaces := make([]string, 0, len(m.namespaces))
+		for pkg, namespace := range m.namespaces {
+			namespaces = append(namespaces, pkg+"="+namespace)
+		}
+		sort.Strings(namespaces)
+		params = append(params, "ruby_namespace="+strings.Join(namespaces, ";"))
+	}
+	if m.namespacePrefix != "" {
+		params = append(params, "ruby_namespace_prefix="+m.namespacePrefix)
+	}
+	for _, name := range []string{"include_files", "exclude_files", "include_packages", "exclude_packages", "include_names", "exclude_names"} {
+		if globs := m.globs[name]; len(globs) > 0 {
+			params = append(params, name+"="+strings.Join(globs, ";"))
+		}
+	}
+	if m.templatesDir != "" {
+		params = append(params, "templates_dir="+m.templatesDir)
+	}
+	if m.headerFile != "" {
+		params = append(params, "header_file="+m.headerFile)
+	}
+	return strings.Join(params, ",")
+}
+
+func (m *Module) Name() string { return "rbi" }
+
+// Execute reports every bad parameter, or every file that can't be generated,
+// through the CodeGeneratorResponse's error, one per line, rather than
+// stopping at the first.
+func (m *Module) Execute(targets map[string]pgs.File, pkgs map[string]pgs.Package) []pgs.Artifact {
+	if m.help {
+		fmt.Fprint(os.Stderr, parametersUsage())
+		return m.Artifacts()
+	}
+	if m.version {
+		fmt.Fprintf(os.Stderr, "protoc-gen-rbi v%s\n", Version)
+		return m.Artifacts()
+	}
+
+	if len(m.errors) > 0 {
+		m.AddError(joinErrors(m.errors))
+		return m.Artifacts()
+	}
+
+	files := make(map[string]pgs.File, len(targets))
+	for name, t := range targets {
+		files[name] = t
+		if !m.includeImports {
+			continue
+		}
+		for _, imp := range t.TransitiveImports() {
+			if m.excludeWKTImports && imp.Package().ProtoName() == pgs.WellKnownTypePackage {
+				continue
+			}
+			files[imp.InputPath().String()] = imp
+		}
+	}
+
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	errs := make([]error, 0)
+	for _, name := range names {
+		t := files[name]
+		if ruby_types.SkipFile(t) || !m.filter.file(t) {
+			continue
+		}
+
+		g := m.generatorFor(t)
+		if fileErrs := m.check(g, t); len(fileErrs) > 0 {
+			errs = append(errs, fileErrs...)
+			continue
+		}
+
+		if err := m.generate(g, t); err != nil {
+			errs = append(errs, err)
+		}
+
+		if len(m.services(t)) > 0 && g.grpc {
+			if err := m.generateServices(g, t); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+	if len(errs) > 0 {
+		m.AddError(joinErrors(errs))
+	}
+	return m.Artifacts()
+}
+
+func (m *Module) generate(g *generator, f pgs.File) error {
+	op := strings.TrimSuffix(f.InputPath().String(), ".proto") + "_pb.rbi"
+	return m.addFile(op, g.tpl, f)
+}
+
+// addFile adds the file rendered by tpl. With validate, it's rendered here to
+// check its syntax, and added with that content rather than rendered again.
+func (m *Module) addFile(name string, tpl *template.Template, f pgs.File) error {
+	if !m.validate {
+		m.AddGeneratorTemplateFile(name, tpl, f)
+		return nil
+	}
+	content, err := validateFile(name, tpl, f)
+	if err != nil {
+		return err
+	}
+	m.AddGeneratorFile(name, content)
+	return nil
+}
+
+// validateFile renders the file and checks its syntax once formatted,
+// reporting the line of the first problem as it would be in the file written.
+// It returns the file as rendered, which RubyFormat formats like the others.
+func validateFile(name string, tpl *template.Template, f pgs.File) (string, error) {
+	var buf bytes.Buffer
+	if err := tpl.Execute(&buf, f); err != nil {
+		return "", fmt.Errorf("%s: %v", name, err)
+	}
+	out, err := formatRuby(buf.Bytes())
+	if err == nil {
+		err = checkRBI(out)
+	}
+	if err != nil {
+		return "", fmt.Errorf("%s:%v", name, err)
+	}
+	return buf.String(), nil
+}
+
+func (m *Module) messages(file pgs.File) []pgs.Message {
+	messages := make([]pgs.Message, 0)
+	for _, message := range ruby_types.Messages(file) {
+		if m.filter.name(message) {
+			messages = append(messages, message)
+		}
+	}
+	return messages
+}
+
+func (m *Module) enums(file pgs.File) []pgs.Enum {
+	enums := make([]pgs.Enum, 0)
+	for _, enum := range ruby_types.Enums(file) {
+		if m.filter.name(enum) {
+			enums = append(enums, enum)
+		}
+	}
+	return enums
+}
+
+func (m *Module) extensions(file pgs.File) []pgs.Extension {
+	extensions := make([]pgs.Extension, 0)
+	for _, extension := range ruby_types.Extensions(file) {
+		if m.filter.extension(extension) {
+			extensions = append(extensions, extension)
+		}
+	}
+	return extensions
+}
+
+func (m *Module) services(file pgs.File) []pgs.Service {
+	services := make([]pgs.Service, 0)
+	for _, service := range file.Services() {
+		if m.filter.service(service) {
+			services = append(services, service)
+		}
+	}
+	return services
+}
+
+func (m *Module) generateServices(g *generator, f pgs.File) error {
+	op := strings.TrimSuffix(f.InputPath().String(), ".proto") + "_services_pb.rbi"
+	return m.addFile(op, g.serviceTpl, f)
+}
+
+// The initializer takes its fields either as keywords or as a positional hash.
+// The positional parameter is named so it can't clash with a keyword.
+func (m *Module) initializerHashParam(fields []pgs.Field) string {
+	for _, field := range fields {
+		if field.Name().String() == "hash" {
+			return "_hash"
+		}
+	}
+	return "hash"
+}
+
+func (m *Module) optional(field pgs.Field) bool {
+	return ruby_types.HasPresence(field)
+}
+
+func (m *Module) optionalOneOf(oneOf pgs.OneOf) bool {
+	return len(oneOf.Fields()) == 1 && oneOf.Fields()[0].Descriptor().GetProto3Optional()
+}
+
+func (m *Module) willGenerateInvalidRuby(fields []pgs.Field) bool {
+	for _, field := range fields {
+		if !validRubyField.MatchString(string(field.Name())) {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *Module) enumValues(enum pgs.Enum) []ruby_types.RubyEnumValue {
+	values := ruby_types.RubyEnumValues(enum)
+	for _, value := range values {
+		if value.Constant == "" {
+			m.Logf("warning: %s: enum value %s.%s is not defined as a constant by the runtime, skipping it",
+				enum.File().InputPath(), fullName(enum), value.Name)
+		}
+	}
+	return values
+}
@@ -0,0 +1,139 @@
+package rbi_generator
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strconv"
+	"text/tabwriter"
+
+	pgs "github.com/lyft/protoc-gen-star"
+)
+
+// parameter is a generator parameter accepted in --rbi_out / --rbi_opt.
+type parameter struct {
+	name  string
+	def   string
+	usage string
+}
+
+// parameters are all the supported parameters, anything else is rejected.
+var parameters = []parameter{
+	{"grpc", strconv.FormatBool(DefaultOptions().GRPC), "generate _services_pb.rbi files for gRPC services"},
+	{"hide_common_methods", strconv.FormatBool(DefaultOptions().HideCommonMethods), "omit the decode, encode and descriptor methods every message has"},
+	{"use_abstract_message", strconv.FormatBool(DefaultOptions().UseAbstractMessage), "make messages inherit from Google::Protobuf::AbstractMessage"},
+	{"strict_enum_getters", strconv.FormatBool(DefaultOptions().StrictEnumGetters), "type proto3 enum getters as Symbol rather than T.any(Symbol, Integer)"},
+	{"qualify_core_types", strconv.FormatBool(DefaultOptions().QualifyCoreTypes), "reference Ruby core classes from the top level, e.g. ::String"},
+	{"extensions", strconv.FormatBool(DefaultOptions().Extensions), "declare typed helpers for the extensions defined by the files, which the runtime doesn't define"},
+	{"ruby_namespace", "", "map proto packages to Ruby namespaces, e.g. acme.billing=Acme::Billing;acme.ledger=Ledger"},
+	{"ruby_namespace_prefix", "", "Ruby namespace to nest the other packages in"},
+	{"include_files", "", "generate only the files matching these globs, e.g. acme/billing/**.proto;acme/ledger/*.proto"},
+	{"exclude_files", "", "don't generate the files matching these globs"},
+	{"include_packages", "", "generate only the files of the proto packages matching these globs, e.g. acme.**"},
+	{"exclude_packages", "", "don't generate the files of the proto packages matching these globs"},
+	{"include_names", "", "generate only the messages, enums, services and extensions whose full names match these globs"},
+	{"exclude_names", "", "don't generate the messages, enums, services and extensions whose full names match these globs"},
+	{"include_imports", strconv.FormatBool(DefaultOptions().IncludeImports), "also generate the files imported by the targets"},
+	{"exclude_wkt_imports", strconv.FormatBool(DefaultOptions().ExcludeWKTImports), "don't generate the imported google.protobuf files"},
+	{"validate", strconv.FormatBool(DefaultOptions().Validate), "check the syntax of the generated files, reporting the file and line of invalid Ruby"},
+	{"typed", DefaultOptions().Typed, "strictness of the # typed: sigil (ignore, false, true, strict or strong), empty to omit it"},
+	{"frozen_string_literal", strconv.FormatBool(DefaultOptions().FrozenStringLiteral), "add the # frozen_string_literal: true magic comment"},
+	{"header_parameters", strconv.FormatBool(DefaultOptions().HeaderParameters), "write the effective parameters in the header, to detect stale files"},
+	{"header_file", "", "file of comments, e.g. a license, written at the top of every file"},
+	{"templates_dir", "", "directory of *.tmpl files redefining blocks of the default templates"},
+	{"config", "", "JSON file to read options from"},
+	{"help", "false", "print the supported parameters and exit"},
+	{"version", "false", "print the version and exit"},
+}
+
+// validateParams returns an error for each parameter that isn't supported,
+// suggesting the closest supported one.
+func validateParams(params pgs.Parameters) []error {
+	names := make([]string, 0, len(params))
+	for name := range params {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var errs []error
+	for _, name := range names {
+		// protoc passes an empty parameter string when there are no options
+		if name == "" || lookupParameter(name) {
+			continue
+		}
+		if suggestion := suggestParameter(name); suggestion != "" {
+			errs = append(errs, fmt.Errorf("unknown parameter %s, did you mean %s?", name, suggestion))
+		} else {
+			errs = append(errs, fmt.Errorf("unknown parameter %s, use help=true to list the supported parameters", name))
+		}
+	}
+	return errs
+}
+
+func lookupParameter(name string) bool {
+	for _, p := range parameters {
+		if p.name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// suggestParameter returns the supported parameter closest to name, if it's
+// close enough to be a typo.
+func suggestParameter(name string) string {
+	best, bestDistance := "", len(name)/2+1
+	for _, p := range parameters {
+		if d := editDistance(name, p.name); d < bestDistance {
+			best, bestDistance = p.name, d
+		}
+	}
+	return best
+}
+
+// editDistance is the Levenshtein distance between a and b.
+func editDistance(a, b string) int {
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(b)]
+}
+
+func min(values ...int) int {
+	m := values[0]
+	for _, v := range values[1:] {
+		if v < m {
+			m = v
+		}
+	}
+	return m
+}
+
+// parametersUsage lists the supported parameters with their defaults.
+func parametersUsage() string {
+	var buf bytes.Buffer
+	fmt.Fprintln(&buf, "protoc-gen-rbi parameters:")
+	w := tabwriter.NewWriter(&buf, 0, 0, 2, ' ', 0)
+	for _, p := range parameters {
+		def := p.def
+		if def == "" {
+			def = `""`
+		}
+		fmt.Fprintf(w, "  %s\t(default %s)\t%s\n", p.name, def, p.usage)
+	}
+	w.Flush()
+	return buf.String()
+}
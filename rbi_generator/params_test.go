@@ -0,0 +1,113 @@
+package rbi_generator
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	pgs "github.com/lyft/protoc-gen-star"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+func TestValidateParams(t *testing.T) {
+	tests := []struct {
+		param string
+		errs  []string
+	}{
+		{"", nil},
+		{"grpc=true,typed=strict", nil},
+		{"grcp=true", []string{"unknown parameter grcp, did you mean grpc?"}},
+		{"hide_comon_methods=true", []string{"unknown parameter hide_comon_methods, did you mean hide_common_methods?"}},
+		{"excludefiles=*.proto,include_name=a.*", []string{
+			"unknown parameter excludefiles, did you mean exclude_files?",
+			"unknown parameter include_name, did you mean include_names?",
+		}},
+		{"colour=red", []string{"unknown parameter colour, use help=true to list the supported parameters"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.param, func(t *testing.T) {
+			errs := validateParams(pgs.ParseParameters(tt.param))
+			if got, want := fmt.Sprint(errs), fmt.Sprint(tt.errs); len(errs) != len(tt.errs) || got != want {
+				t.Errorf("got %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestEditDistance(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"", "", 0},
+		{"grpc", "grpc", 0},
+		{"", "grpc", 4},
+		{"grpc", "", 4},
+		{"grcp", "grpc", 2},
+		{"gprc", "grpc", 2},
+		{"typd", "typed", 1},
+		{"kitten", "sitting", 3},
+	}
+	for _, tt := range tests {
+		if got := editDistance(tt.a, tt.b); got != tt.want {
+			t.Errorf("editDistance(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+// Files generated with different parameters are detected as stale by the
+// parameters in their header.
+func TestHeaderParameters(t *testing.T) {
+	files := loadDescriptorSet(t, "testdata")
+	parameters := func(param string) string {
+		req := &pluginpb.CodeGeneratorRequest{
+			FileToGenerate: []string{"subdir/messages.proto"},
+			Parameter:      proto.String("header_parameters=true," + param),
+			ProtoFile:      files,
+		}
+		res, err := Generate(req, DefaultOptions())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.Error != nil {
+			t.Fatal(res.GetError())
+		}
+		for _, line := range strings.Split(res.File[0].GetContent(), "\n") {
+			if strings.HasPrefix(line, "# parameters: ") {
+				return line
+			}
+		}
+		t.Fatalf("no parameters in the header of %s", res.File[0].GetName())
+		return ""
+	}
+
+	// the templates of testdata/templates replace the header
+	templates := t.TempDir()
+	if err := ioutil.WriteFile(filepath.Join(templates, "field.tmpl"), []byte(`{{ define "field" }}{{ end }}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	base := parameters("")
+	for _, param := range []string{
+		"grpc=false",
+		"ruby_namespace=testdata.subdir=Acme::Proto",
+		"include_files=subdir/*.proto",
+		"exclude_files=other.proto",
+		"include_packages=testdata.**",
+		"exclude_packages=other",
+		"include_names=testdata.subdir.*",
+		"exclude_names=testdata.subdir.IntegerMessage",
+		"include_imports=true",
+		"exclude_wkt_imports=true",
+		"validate=true",
+		"templates_dir=" + templates,
+		"header_file=testdata/license_header.txt",
+	} {
+		if got := parameters(param); got == base {
+			t.Errorf("%s isn't in the header parameters %q", param, got)
+		}
+	}
+}
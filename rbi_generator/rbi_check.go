@@ -0,0 +1,222 @@
+package rbi_generator
+
+import (
+	"fmt"
+	"strings"
+)
+
+// checkRBI checks the syntax of a .rbi file, as far as the Ruby it's made of
+// goes: every token is known, blocks and brackets are balanced, sigs are
+// followed by methods, and classes, modules and methods are defined with valid
+// names and parameters. Errors are prefixed with the line and column.
+func checkRBI(src []byte) error {
+	if _, err := formatRuby(src); err != nil {
+		return err
+	}
+
+	// whether the parameters of a method continue on the next line
+	inParameters := false
+	for i, line := range strings.Split(string(src), "\n") {
+		tokens := lexRuby(line)
+		for _, token := range tokens {
+			if token.kind == unknownToken {
+				return fmt.Errorf("%d:%d: unexpected %q", i+1, token.column, token.text)
+			}
+		}
+
+		c := &lineChecker{tokens: tokens, inParameters: inParameters}
+		if err := c.check(); err != nil {
+			return fmt.Errorf("%d:%d: %v", i+1, c.column(), err)
+		}
+		inParameters = c.inParameters
+	}
+	return nil
+}
+
+// lineChecker checks the definitions of a line.
+type lineChecker struct {
+	tokens       []rubyToken
+	i            int
+	inParameters bool
+}
+
+func (c *lineChecker) peek() rubyToken {
+	if c.i < len(c.tokens) {
+		return c.tokens[c.i]
+	}
+	return rubyToken{}
+}
+
+func (c *lineChecker) next() rubyToken {
+	token := c.peek()
+	c.i++
+	return token
+}
+
+func (c *lineChecker) is(kind tokenKind, text string) bool {
+	token := c.peek()
+	return c.i < len(c.tokens) && token.kind == kind && token.text == text
+}
+
+// column is the column of the token the checker stopped at, or the end of
+// the line.
+func (c *lineChecker) column() int {
+	if c.i < len(c.tokens) {
+		return c.tokens[c.i].column
+	}
+	if len(c.tokens) > 0 {
+		last := c.tokens[len(c.tokens)-1]
+		return last.column + len(last.text)
+	}
+	return 1
+}
+
+func (c *lineChecker) check() error {
+	if c.inParameters {
+		if err := c.parameters(); err != nil || c.inParameters {
+			return err
+		}
+		return c.endOfDefinition()
+	}
+
+	for j := 0; j+1 < len(c.tokens); j++ {
+		if c.tokens[j].kind == punctuationToken && c.tokens[j].text == "::" && c.tokens[j+1].kind != constantToken {
+			c.i = j + 1
+			return fmt.Errorf("expected a constant after ::")
+		}
+	}
+
+	switch {
+	case c.is(keywordToken, "class"):
+		c.next()
+		if c.is(punctuationToken, "<<") {
+			c.next()
+			if !c.is(keywordToken, "self") {
+				return fmt.Errorf("expected self after class <<")
+			}
+			c.next()
+		} else {
+			if err := c.constantPath(); err != nil {
+				return err
+			}
+			if c.is(punctuationToken, "<") {
+				c.next()
+				if err := c.constantPath(); err != nil {
+					return err
+				}
+			}
+		}
+		return c.endOfDefinition()
+
+	case c.is(keywordToken, "module"):
+		c.next()
+		if err := c.constantPath(); err != nil {
+			return err
+		}
+		return c.endOfDefinition()
+
+	case c.is(keywordToken, "def"):
+		c.next()
+		if c.is(keywordToken, "self") {
+			c.next()
+			if !c.is(punctuationToken, ".") {
+				return fmt.Errorf("expected . after def self")
+			}
+			c.next()
+		}
+		if c.peek().kind != methodNameToken {
+			return fmt.Errorf("expected a method name")
+		}
+		c.next()
+		if c.is(punctuationToken, "(") {
+			c.next()
+			if err := c.parameters(); err != nil || c.inParameters {
+				return err
+			}
+		}
+		return c.endOfDefinition()
+	}
+	return nil
+}
+
+// constantPath checks a constant, e.g. `::Google::Protobuf::Descriptor`.
+func (c *lineChecker) constantPath() error {
+	if c.is(punctuationToken, "::") {
+		c.next()
+	}
+	for {
+		if c.peek().kind != constantToken {
+			return fmt.Errorf("expected a constant")
+		}
+		c.next()
+		if !c.is(punctuationToken, "::") {
+			return nil
+		}
+		c.next()
+	}
+}
+
+// endOfDefinition checks that a definition ends the line, or is followed by
+// `; end`.
+func (c *lineChecker) endOfDefinition() error {
+	if c.is(punctuationToken, ";") {
+		c.next()
+		if !c.is(keywordToken, "end") {
+			return fmt.Errorf("expected end after ;")
+		}
+		c.next()
+	}
+	if c.i < len(c.tokens) {
+		return fmt.Errorf("unexpected %q", c.peek().text)
+	}
+	return nil
+}
+
+// parameters checks the parameters of a method, up to their closing `)` which
+// may be on a later line.
+func (c *lineChecker) parameters() error {
+	c.inParameters = true
+	for c.i < len(c.tokens) {
+		if c.is(punctuationToken, ")") {
+			c.next()
+			c.inParameters = false
+			return nil
+		}
+
+		if c.is(punctuationToken, "*") || c.is(punctuationToken, "**") || c.is(punctuationToken, "&") {
+			c.next()
+		}
+		switch token := c.peek(); {
+		case token.kind == identifierToken:
+			c.next()
+		case token.kind == labelToken:
+			if !isWordStart(token.text[0]) || isUpper(token.text[0]) {
+				return fmt.Errorf("invalid keyword parameter %s", strings.TrimSuffix(token.text, ":"))
+			}
+			c.next()
+		default:
+			return fmt.Errorf("invalid parameter %s", token.text)
+		}
+
+		// skip the default value
+		depth := 0
+		for ; c.i < len(c.tokens); c.i++ {
+			token := c.tokens[c.i]
+			if token.kind != punctuationToken {
+				continue
+			}
+			if token.text == "(" || token.text == "[" || token.text == "{" {
+				depth++
+			} else if token.text == ")" || token.text == "]" || token.text == "}" {
+				if depth == 0 {
+					break
+				}
+				depth--
+			} else if token.text == "," && depth == 0 {
+				c.i++
+				break
+			}
+		}
+	}
+	return nil
+}
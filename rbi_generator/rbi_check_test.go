@@ -0,0 +1,36 @@
+package rbi_generator
+
+import (
+	"testing"
+)
+
+func TestCheckRBI(t *testing.T) {
+	tests := []struct {
+		src string
+		err string
+	}{
+		{"class Foo < ::Google::Protobuf::AbstractMessage\n  sig { returns(::String) }\n  def name; end\nend\n", ""},
+		{"module Acme::Billing\n  class << self\n    def []=(key, value); end\n  end\nend\n", ""},
+		{"class Foo\n  sig do\n    params(\n      hash: T.untyped\n    ).void\n  end\n  def initialize(\n    hash = nil,\n    name: \"\"\n  ); end\nend\n", ""},
+		{"class Foo\n  def name; end\n", "1: class isn't closed"},
+		{"class Foo\n  sig { void }\nend\n", "2: sig isn't followed by a method definition"},
+		{"class Foo\n  sig { void }\n  sig { void }\n  def foo; end\nend\n", "2: sig isn't followed by a method definition"},
+		{"class foo\nend\n", "1:7: expected a constant"},
+		{"class Foo < Bar::baz\nend\n", "1:18: expected a constant after ::"},
+		{"module Foo Bar\nend\n", "1:12: unexpected \"Bar\""},
+		{"def initialize(\n  hash = nil,\n  Constant_1: 0\n); end\n", "3:3: invalid keyword parameter Constant_1"},
+		{"def 1; end\n", "1:5: expected a method name"},
+		{"def name; foo; end\n", "1:11: expected end after ;"},
+		{"X = \"unterminated\n", "1:5: unexpected \"\\\"unterminated\""},
+	}
+	for _, test := range tests {
+		err := checkRBI([]byte(test.src))
+		got := ""
+		if err != nil {
+			got = err.Error()
+		}
+		if got != test.err {
+			t.Errorf("checkRBI(%q) = %q, want %q", test.src, got, test.err)
+		}
+	}
+}
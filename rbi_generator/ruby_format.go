@@ -0,0 +1,170 @@
+package rbi_generator
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	pgs "github.com/lyft/protoc-gen-star"
+)
+
+type rubyFormat struct {
+	// the artifact being processed, as Match is called right before Process
+	name string
+}
+
+// RubyFormat returns a PostProcessor that formats the .rbi files: lines are
+// reindented by two spaces per block, trailing whitespace and repeated blank
+// lines are removed. It fails on unbalanced blocks, brackets and sigs not
+// followed by a method, which would make the file fail to parse.
+func RubyFormat() pgs.PostProcessor { return &rubyFormat{} }
+
+func (p *rubyFormat) Match(a pgs.Artifact) bool {
+	switch a := a.(type) {
+	case pgs.GeneratorFile:
+		p.name = a.Name
+	case pgs.GeneratorTemplateFile:
+		p.name = a.Name
+	case pgs.CustomFile:
+		p.name = a.Name
+	case pgs.CustomTemplateFile:
+		p.name = a.Name
+	default:
+		return false
+	}
+
+	return strings.HasSuffix(p.name, ".rbi")
+}
+
+func (p *rubyFormat) Process(in []byte) ([]byte, error) {
+	out, err := formatRuby(in)
+	if err != nil {
+		return nil, fmt.Errorf("%s:%v", p.name, err)
+	}
+	return out, nil
+}
+
+var _ pgs.PostProcessor = &rubyFormat{}
+
+// opener is a keyword or bracket opening a block, closed by an `end` or the
+// matching bracket.
+type opener struct {
+	token string
+	line  int
+	// whether the lines after it are indented. Of the openers of a line only
+	// the last one still open indents, e.g. `def initialize(` indents the
+	// parameters, then the body once the `)` is closed.
+	indents bool
+}
+
+// blockKeywords open a block anywhere on a line, lineKeywords only at the
+// start of it since they're modifiers otherwise.
+var (
+	blockKeywords = map[string]bool{"class": true, "module": true, "def": true, "do": true}
+	lineKeywords  = map[string]bool{"if": true, "unless": true, "while": true, "until": true, "case": true, "begin": true}
+	brackets      = map[string]string{")": "(", "]": "[", "}": "{"}
+)
+
+func isCloser(token rubyToken) bool {
+	return (token.kind == keywordToken && token.text == "end") || (token.kind == punctuationToken && brackets[token.text] != "")
+}
+
+func isOpener(token rubyToken) bool {
+	switch token.kind {
+	case keywordToken:
+		return blockKeywords[token.text] || (token.first && lineKeywords[token.text])
+	case punctuationToken:
+		return token.text == "(" || token.text == "[" || token.text == "{"
+	}
+	return false
+}
+
+// formatRuby reindents and checks the Ruby source. It only knows the subset of
+// Ruby found in .rbi files, e.g. strings can't span lines.
+func formatRuby(in []byte) ([]byte, error) {
+	var (
+		buf   bytes.Buffer
+		stack []*opener
+		blank = true
+		// the line of the last sig, and the depth of its block
+		sigLine, sigDepth int
+	)
+
+	indentation := func() int {
+		n := 0
+		for _, o := range stack {
+			if o.indents {
+				n++
+			}
+		}
+		return n
+	}
+
+	for i, line := range strings.Split(string(in), "\n") {
+		number := i + 1
+		line = strings.TrimSpace(line)
+		if line == "" {
+			// repeated blank lines, and those at the start of the file, are removed
+			if !blank {
+				buf.WriteString("\n")
+			}
+			blank = true
+			continue
+		}
+
+		tokens := lexRuby(line)
+		if sigLine != 0 && len(stack) == sigDepth && !strings.HasPrefix(line, "#") {
+			if len(tokens) == 0 || tokens[0].kind != keywordToken || tokens[0].text != "def" {
+				return nil, fmt.Errorf("%d: sig isn't followed by a method definition", sigLine)
+			}
+			sigLine = 0
+		}
+
+		// lines starting with closers are dedented to the level of their opener
+		indent := -1
+		for _, token := range tokens {
+			if !isCloser(token) && indent < 0 {
+				indent = indentation()
+			}
+
+			switch {
+			case isCloser(token):
+				if len(stack) == 0 {
+					return nil, fmt.Errorf("%d: unexpected %s", number, token.text)
+				}
+				top := stack[len(stack)-1]
+				if want := brackets[token.text]; want != top.token && (want != "" || !(blockKeywords[top.token] || lineKeywords[top.token])) {
+					return nil, fmt.Errorf("%d: unexpected %s, %s from line %d isn't closed", number, token.text, top.token, top.line)
+				}
+				stack = stack[:len(stack)-1]
+			case isOpener(token):
+				stack = append(stack, &opener{token: token.text, line: number})
+			case token.first && token.kind == identifierToken && token.text == "sig":
+				sigLine, sigDepth = number, len(stack)
+			}
+		}
+		if indent < 0 {
+			indent = indentation()
+		}
+		if len(stack) > 0 {
+			// the last opener indents, which may be one left open by an earlier
+			// opener of its line being closed, e.g. the def of the `)` closing
+			// its parameters
+			stack[len(stack)-1].indents = true
+		}
+
+		buf.WriteString(strings.Repeat("  ", indent))
+		buf.WriteString(line)
+		buf.WriteString("\n")
+		blank = false
+	}
+
+	if len(stack) > 0 {
+		top := stack[len(stack)-1]
+		return nil, fmt.Errorf("%d: %s isn't closed", top.line, top.token)
+	}
+	if sigLine != 0 {
+		return nil, fmt.Errorf("%d: sig isn't followed by a method definition", sigLine)
+	}
+	return append(bytes.TrimRight(buf.Bytes(), "\n"), '\n'), nil
+}
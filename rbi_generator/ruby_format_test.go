@@ -0,0 +1,56 @@
+package rbi_generator
+
+import (
+	"testing"
+)
+
+func TestFormatRuby(t *testing.T) {
+	tests := []struct {
+		src string
+		out string
+		err string
+	}{
+		{
+			src: "class Foo\nsig { returns(::String) }\ndef name; end\nend",
+			out: "class Foo\n  sig { returns(::String) }\n  def name; end\nend\n",
+		},
+		{
+			src: "\n\nmodule Foo  \n\n\n\nclass Bar\n\n\nend\n\n\nend\n\n",
+			out: "module Foo\n\n  class Bar\n\n  end\n\nend\n",
+		},
+		{
+			src: "class Foo\nsig do\nparams(\nhash: T.untyped\n).void\nend\ndef initialize(\nhash = nil\n); end\nend\n",
+			out: "class Foo\n  sig do\n    params(\n      hash: T.untyped\n    ).void\n  end\n  def initialize(\n    hash = nil\n  ); end\nend\n",
+		},
+		{
+			src: "class Foo\nX = 1 if true\nunless Y\nZ = 2\nend\nend\n",
+			out: "class Foo\n  X = 1 if true\n  unless Y\n    Z = 2\n  end\nend\n",
+		},
+		{
+			src: "class Foo\n# comment\nsig { void }\n# comment\ndef foo; end\nend\n",
+			out: "class Foo\n  # comment\n  sig { void }\n  # comment\n  def foo; end\nend\n",
+		},
+		{src: "class Foo\nend\nend\n", err: "3: unexpected end"},
+		{src: "class Foo\ndef foo(\nend\nend\n", err: "3: unexpected end, ( from line 2 isn't closed"},
+		{src: "class Foo\nX = [1, 2)\nend\n", err: "2: unexpected ), [ from line 2 isn't closed"},
+		{src: "X = [\n1\n", err: "1: [ isn't closed"},
+		{src: "class Foo\ndef foo; end\n", err: "1: class isn't closed"},
+		{src: "class Foo\nsig { void }\nX = 1\nend\n", err: "2: sig isn't followed by a method definition"},
+		{src: "sig { void }\n", err: "1: sig isn't followed by a method definition"},
+		{src: "class Foo\nX = 1 if true\nend\nend\n", err: "4: unexpected end"},
+	}
+	for _, test := range tests {
+		out, err := formatRuby([]byte(test.src))
+		got := ""
+		if err != nil {
+			got = err.Error()
+		}
+		if got != test.err {
+			t.Errorf("formatRuby(%q) error = %q, want %q", test.src, got, test.err)
+			continue
+		}
+		if string(out) != test.out {
+			t.Errorf("formatRuby(%q) = %q, want %q", test.src, out, test.out)
+		}
+	}
+}
@@ -0,0 +1,191 @@
+package rbi_generator
+
+import (
+	"strings"
+)
+
+type tokenKind int
+
+const (
+	unknownToken tokenKind = iota
+	keywordToken
+	identifierToken
+	constantToken
+	labelToken
+	symbolToken
+	stringToken
+	numberToken
+	punctuationToken
+	// the name of a method being defined
+	methodNameToken
+)
+
+type rubyToken struct {
+	kind tokenKind
+	text string
+	// the column the token starts at, from 1
+	column int
+	// whether the token starts the line
+	first bool
+}
+
+var rubyKeywords = map[string]bool{
+	"alias": true, "and": true, "begin": true, "break": true, "case": true, "class": true, "def": true,
+	"defined?": true, "do": true, "else": true, "elsif": true, "end": true, "ensure": true, "false": true,
+	"for": true, "if": true, "in": true, "module": true, "next": true, "nil": true, "not": true, "or": true,
+	"redo": true, "rescue": true, "retry": true, "return": true, "self": true, "super": true, "then": true,
+	"true": true, "undef": true, "unless": true, "until": true, "when": true, "while": true, "yield": true,
+}
+
+// punctuation, longest first so that e.g. `::` isn't lexed as two `:`
+var rubyPunctuation = []string{
+	"<=>", "**", "::", "<<", "==", "!=", "<=", ">=", "->", "=>", "&&", "||",
+	"(", ")", "[", "]", "{", "}", ",", ".", ";", "=", "<", ">", "*", "&", "|", "+", "-", "!", "?", ":", "/", "%",
+}
+
+// operatorMethods are the method names defined in .rbi files that aren't
+// identifiers.
+var operatorMethods = []string{"[]=", "[]", "<=>", "==", "!="}
+
+// lexRuby splits a line of the Ruby found in .rbi files into tokens, up to a
+// comment. Anything else is returned as an unknownToken, e.g. an unterminated
+// string, instance variables or heredocs.
+func lexRuby(line string) []rubyToken {
+	tokens := make([]rubyToken, 0)
+	add := func(kind tokenKind, start, end int) {
+		tokens = append(tokens, rubyToken{kind: kind, text: line[start:end], column: start + 1, first: len(tokens) == 0})
+	}
+	// whether the next token is the name of a method being defined
+	defining := func() bool {
+		n := len(tokens)
+		if n >= 1 && tokens[n-1].kind == keywordToken && tokens[n-1].text == "def" {
+			return true
+		}
+		return n >= 3 && tokens[n-3].text == "def" && tokens[n-2].text == "self" && tokens[n-1].text == "."
+	}
+	// whether the previous token makes the next one a method call or constant path
+	qualified := func() bool {
+		n := len(tokens)
+		return n >= 1 && tokens[n-1].kind == punctuationToken && (tokens[n-1].text == "." || tokens[n-1].text == "::")
+	}
+
+	for i := 0; i < len(line); {
+		c := line[i]
+		switch {
+		case c == ' ' || c == '\t':
+			i++
+
+		case c == '#':
+			return tokens
+
+		case c == '"' || c == '\'':
+			start := i
+			for i++; i < len(line) && line[i] != c; i++ {
+				if line[i] == '\\' {
+					i++
+				}
+			}
+			if i >= len(line) {
+				add(unknownToken, start, len(line))
+				return tokens
+			}
+			i++
+			add(stringToken, start, i)
+
+		case isDigit(c):
+			start := i
+			for i < len(line) && (isDigit(line[i]) || line[i] == '_' || (line[i] == '.' && i+1 < len(line) && isDigit(line[i+1]))) {
+				i++
+			}
+			add(numberToken, start, i)
+
+		case isWordStart(c):
+			start := i
+			for i < len(line) && isWordChar(line[i]) {
+				i++
+			}
+			if i < len(line) && (line[i] == '?' || line[i] == '!') && !strings.HasPrefix(line[i:], "!=") {
+				i++
+			}
+			word := line[start:i]
+			switch {
+			case defining():
+				// setters, e.g. def name=(value)
+				if i < len(line) && line[i] == '=' && !strings.HasPrefix(line[i:], "==") && word[len(word)-1] != '?' {
+					i++
+				}
+				if word == "self" && i < len(line) && line[i] == '.' {
+					add(keywordToken, start, i)
+				} else {
+					add(methodNameToken, start, i)
+				}
+			case i < len(line) && line[i] == ':' && !strings.HasPrefix(line[i:], "::") && !qualified():
+				i++
+				add(labelToken, start, i)
+			case isUpper(c):
+				add(constantToken, start, i)
+			case rubyKeywords[word] && !qualified():
+				add(keywordToken, start, i)
+			default:
+				add(identifierToken, start, i)
+			}
+
+		case c == ':' && i+1 < len(line) && isWordStart(line[i+1]):
+			start := i
+			for i++; i < len(line) && isWordChar(line[i]); i++ {
+			}
+			if i < len(line) && (line[i] == '?' || line[i] == '!' || line[i] == '=') {
+				i++
+			}
+			add(symbolToken, start, i)
+
+		default:
+			if defining() {
+				if name := operatorMethod(line[i:]); name != "" {
+					add(methodNameToken, i, i+len(name))
+					i += len(name)
+					continue
+				}
+			}
+			p := punctuation(line[i:])
+			if p == "" {
+				add(unknownToken, i, i+1)
+				i++
+				continue
+			}
+			add(punctuationToken, i, i+len(p))
+			i += len(p)
+		}
+	}
+	return tokens
+}
+
+func operatorMethod(s string) string {
+	for _, name := range operatorMethods {
+		if strings.HasPrefix(s, name) {
+			return name
+		}
+	}
+	return ""
+}
+
+func punctuation(s string) string {
+	for _, p := range rubyPunctuation {
+		if strings.HasPrefix(s, p) {
+			return p
+		}
+	}
+	return ""
+}
+
+func isDigit(c byte) bool { return c >= '0' && c <= '9' }
+
+func isUpper(c byte) bool { return c >= 'A' && c <= 'Z' }
+
+func isWordStart(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || isUpper(c)
+}
+
+func isWordChar(c byte) bool {
+	return isWordStart(c) || isDigit(c)
+}
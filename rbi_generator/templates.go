@@ -0,0 +1,188 @@
+package rbi_generator
+
+// templates are the default templates. Files are rendered by the "file" and
+// "services" templates, and every block they're made of can be redefined in a
+// templates_dir.
+const templates = `{{ define "file" }}{{ template "header" . }}{{ template "namespaces" . }}{{ range messages . }}{{ template "message" . }}{{ end }}{{ range enums . }}{{ template "enum" . }}{{ end }}{{ if extensions }}{{ range definedExtensions . }}{{ template "extension" . }}{{ end }}{{ end }}{{ end }}
+
+{{ define "services" }}{{ template "header" . }}{{ template "serviceNamespaces" . }}{{ range services . }}{{ template "service" . }}{{ end }}{{ end }}
+
+{{ define "header" }}{{ header }}# Code generated by protoc-gen-rbi v{{ version }}. DO NOT EDIT.
+# source: {{ .InputPath }}
+{{ with headerParameters }}# parameters: {{ . }}
+{{ end }}{{ if frozenStringLiteral }}# frozen_string_literal: true
+{{ end }}{{ with typed }}# typed: {{ . }}
+{{ end }}{{ end }}
+
+{{ define "namespaces" }}{{ with rubyNamespaces . }}
+{{ range . }}module {{ . }}; end
+{{ end }}{{ end }}{{ end }}
+
+{{ define "serviceNamespaces" }}{{ with rubyServiceNamespaces . }}
+{{ range . }}module {{ . }}; end
+{{ end }}{{ end }}{{ end }}
+
+{{ define "message" }}{{ template "messageHeader" . }}{{ if hideCommonMethods }}{{ else }}{{ template "messageClassMethods" . }}{{ end }}{{ template "initializer" . }}{{ range fields . }}{{ template "field" . }}{{ end }}{{ range .OneOfs }}{{ if not (optionalOneOf .) }}{{ template "oneOf" . }}{{ end }}{{ end }}{{ if hideCommonMethods }}{{ else }}{{ template "messageInstanceMethods" . }}{{ end }}end
+{{ end }}
+
+{{ define "messageHeader" }}
+class {{ rubyMessageType . }}{{ if useAbstractMessage }} < ::Google::Protobuf::AbstractMessage{{ else }}
+  include ::Google::Protobuf::MessageExts
+  extend ::Google::Protobuf::MessageExts::ClassMethods
+{{ end }}{{ end }}
+
+{{ define "messageClassMethods" }}
+  sig { params(str: {{ coreType "String" }}).returns({{ rubyMessageType . }}) }
+  def self.decode(str)
+  end
+
+  sig { params(msg: {{ rubyMessageType . }}).returns({{ coreType "String" }}) }
+  def self.encode(msg)
+  end
+
+  sig { params(str: {{ coreType "String" }}, kw: T.untyped).returns({{ rubyMessageType . }}) }
+  def self.decode_json(str, **kw)
+  end
+
+  sig { params(msg: {{ rubyMessageType . }}, kw: T.untyped).returns({{ coreType "String" }}) }
+  def self.encode_json(msg, **kw)
+  end
+
+  sig { returns(::Google::Protobuf::Descriptor) }
+  def self.descriptor
+  end
+{{ end }}
+
+{{ define "initializer" }}{{ if willGenerateInvalidRuby (fields .) }}
+  # Constants of the form Constant_1 are invalid. We've declined to type this as a result, taking a hash instead.
+  sig { params(args: T::Hash[T.untyped, T.untyped]).void }
+  def initialize(args); end
+{{ else if gt (len (fields .)) 0 }}{{ $hash := initializerHashParam (fields .) }}
+  sig do
+    params(
+      {{ $hash }}: T.nilable(T::Hash[{{ coreType "T.any(Symbol, String)" }}, T.untyped]){{ range fields . }},
+      {{ .Name }}: {{ rubyInitializerFieldType . }}{{ end }}
+    ).void
+  end
+  def initialize(
+    {{ $hash }} = nil{{ range fields . }},
+    {{ .Name }}: {{ rubyFieldValue . }}{{ end }}
+  )
+  end
+{{ else }}
+  sig { params(hash: T.nilable(T::Hash[{{ coreType "T.any(Symbol, String)" }}, T.untyped])).void }
+  def initialize(hash = nil); end
+{{ end }}{{ end }}
+
+{{ define "field" }}
+  sig { returns({{ rubyGetterFieldType . }}) }
+  def {{ .Name }}
+  end
+
+  sig { params(value: {{ rubySetterFieldType . }}).void }
+  def {{ .Name }}=(value)
+  end
+
+  sig { void }
+  def clear_{{ .Name }}
+  end
+{{ if optional . }}
+  sig { returns(T::Boolean) }
+  def has_{{ .Name }}?
+  end
+{{ end }}{{ if isWrapperField . }}
+  sig { returns({{ rubyWrapperGetterType . }}) }
+  def {{ .Name }}_as_value
+  end
+
+  sig { params(value: {{ rubyWrapperSetterType . }}).void }
+  def {{ .Name }}_as_value=(value)
+  end
+{{ end }}{{ end }}
+
+{{ define "oneOf" }}
+  sig { returns(T.nilable({{ coreType "Symbol" }})) }
+  def {{ .Name }}
+  end
+{{ end }}
+
+{{ define "messageInstanceMethods" }}
+  sig { params(field: {{ coreType "String" }}).returns(T.untyped) }
+  def [](field)
+  end
+
+  sig { params(field: {{ coreType "String" }}, value: T.untyped).void }
+  def []=(field, value)
+  end
+
+  sig { returns(T::Hash[{{ coreType "Symbol" }}, T.untyped]) }
+  def to_h
+  end
+{{ end }}
+
+{{ define "enum" }}
+module {{ rubyMessageType . }}{{ range enumValues . }}{{ if .Constant }}
+  self::{{ .Constant }} = T.let({{ .Value }}, {{ coreType "Integer" }}){{ else }}
+  # {{ .Name }} = {{ .Value }} is not defined as a constant by the runtime{{ end }}{{ end }}
+
+  sig { params(value: {{ coreType "Integer" }}).returns(T.nilable({{ coreType "Symbol" }})) }
+  def self.lookup(value)
+  end
+
+  sig { params(value: {{ coreType "Symbol" }}).returns(T.nilable({{ coreType "Integer" }})) }
+  def self.resolve(value)
+  end
+
+  sig { returns(::Google::Protobuf::EnumDescriptor) }
+  def self.descriptor
+  end
+end
+{{ end }}
+
+{{ define "extension" }}
+# {{ fullName . }}, extending {{ rubyMessageType .Extendee }} with field {{ .Descriptor.GetNumber }}:
+# ::Google::Protobuf::DescriptorPool.generated_pool.lookup("{{ fullName . }}")
+module {{ rubyExtensionModule . }}
+  sig { returns(::Google::Protobuf::FieldDescriptor) }
+  def self.descriptor
+  end
+
+  sig { params(message: {{ rubyMessageType .Extendee }}).returns({{ rubyGetterFieldType . }}) }
+  def self.get(message)
+  end
+
+  sig { params(message: {{ rubyMessageType .Extendee }}, value: {{ rubySetterFieldType . }}).void }
+  def self.set(message, value)
+  end
+end
+{{ end }}
+
+{{ define "service" }}
+module {{ rubyServiceModule . }}
+  class Service
+    include ::GRPC::GenericService
+  end
+
+  class Stub < ::GRPC::ClientStub
+    sig do
+      params(
+        host: {{ coreType "String" }},
+        creds: T.any(::GRPC::Core::ChannelCredentials, {{ coreType "Symbol" }}),
+        kw: T.untyped,
+      ).void
+    end
+    def initialize(host, creds, **kw)
+    end{{ range .Methods }}{{ template "stubMethod" . }}{{ end }}
+  end
+end
+{{ end }}
+
+{{ define "stubMethod" }}
+
+    sig do
+      params(
+        request: {{ rubyMethodParamType . }}
+      ).returns({{ rubyMethodReturnType . }})
+    end
+    def {{ .Name.LowerSnakeCase }}(request)
+    end{{ end }}`
@@ -0,0 +1,5 @@
+package rbi_generator
+
+// Version is the version of protoc-gen-rbi, written in the header of the
+// generated files.
+const Version = "0.1.0"
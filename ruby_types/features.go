@@ -0,0 +1,123 @@
+package ruby_types
+
+import (
+	pgs "github.com/lyft/protoc-gen-star"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// Helpers resolving the features of editions files like protoc does: the
+// defaults of the file's edition, overridden by the features set on the file,
+// on the messages enclosing the entity from the outermost in, then on the
+// entity itself. proto2 and proto3 files can't set features, and resolve to
+// the ones matching their semantics.
+
+// editionDefaults are the defaults of the features affecting the generated
+// types, by the edition they were introduced in.
+var editionDefaults = []struct {
+	edition  descriptorpb.Edition
+	features *descriptorpb.FeatureSet
+}{
+	{descriptorpb.Edition_EDITION_PROTO2, &descriptorpb.FeatureSet{
+		FieldPresence:         descriptorpb.FeatureSet_EXPLICIT.Enum(),
+		EnumType:              descriptorpb.FeatureSet_CLOSED.Enum(),
+		RepeatedFieldEncoding: descriptorpb.FeatureSet_EXPANDED.Enum(),
+	}},
+	{descriptorpb.Edition_EDITION_PROTO3, &descriptorpb.FeatureSet{
+		FieldPresence:         descriptorpb.FeatureSet_IMPLICIT.Enum(),
+		EnumType:              descriptorpb.FeatureSet_OPEN.Enum(),
+		RepeatedFieldEncoding: descriptorpb.FeatureSet_PACKED.Enum(),
+	}},
+	{descriptorpb.Edition_EDITION_2023, &descriptorpb.FeatureSet{
+		FieldPresence:         descriptorpb.FeatureSet_EXPLICIT.Enum(),
+		EnumType:              descriptorpb.FeatureSet_OPEN.Enum(),
+		RepeatedFieldEncoding: descriptorpb.FeatureSet_PACKED.Enum(),
+	}},
+}
+
+// MinimumEdition and MaximumEdition are the editions the generator supports.
+const (
+	MinimumEdition = descriptorpb.Edition_EDITION_PROTO2
+	MaximumEdition = descriptorpb.Edition_EDITION_2023
+)
+
+func fileEdition(file pgs.File) descriptorpb.Edition {
+	switch file.Descriptor().GetSyntax() {
+	case "proto3":
+		return descriptorpb.Edition_EDITION_PROTO3
+	case "editions":
+		return file.Descriptor().GetEdition()
+	}
+	return descriptorpb.Edition_EDITION_PROTO2
+}
+
+// scopeFeatures returns the features of the file or message the entity is
+// defined in.
+func scopeFeatures(scope pgs.ParentEntity) *descriptorpb.FeatureSet {
+	switch s := scope.(type) {
+	case pgs.File:
+		edition := fileEdition(s)
+		features := editionDefaults[0].features
+		for _, defaults := range editionDefaults {
+			if defaults.edition <= edition {
+				features = defaults.features
+			}
+		}
+		features = proto.Clone(features).(*descriptorpb.FeatureSet)
+		proto.Merge(features, s.Descriptor().GetOptions().GetFeatures())
+		return features
+	case pgs.Message:
+		features := scopeFeatures(s.Parent())
+		proto.Merge(features, s.Descriptor().GetOptions().GetFeatures())
+		return features
+	}
+	return &descriptorpb.FeatureSet{}
+}
+
+// FieldFeatures returns the resolved features of a field or extension.
+func FieldFeatures(field pgs.Field) *descriptorpb.FeatureSet {
+	var features *descriptorpb.FeatureSet
+	if extension, ok := field.(pgs.Extension); ok {
+		features = scopeFeatures(extension.DefinedIn())
+	} else {
+		features = scopeFeatures(field.Message())
+		if field.InOneOf() {
+			proto.Merge(features, field.OneOf().Descriptor().GetOptions().GetFeatures())
+		}
+	}
+	proto.Merge(features, field.Descriptor().GetOptions().GetFeatures())
+
+	switch {
+	case field.Descriptor().GetProto3Optional():
+		features.FieldPresence = descriptorpb.FeatureSet_EXPLICIT.Enum()
+	case field.Descriptor().GetLabel() == descriptorpb.FieldDescriptorProto_LABEL_REQUIRED:
+		features.FieldPresence = descriptorpb.FeatureSet_LEGACY_REQUIRED.Enum()
+	}
+	return features
+}
+
+// EnumFeatures returns the resolved features of an enum.
+func EnumFeatures(enum pgs.Enum) *descriptorpb.FeatureSet {
+	features := scopeFeatures(enum.Parent())
+	proto.Merge(features, enum.Descriptor().GetOptions().GetFeatures())
+	return features
+}
+
+// HasPresence reports whether the runtime tracks whether a singular field is
+// set, and defines its has_ method. Like protoc, message fields and the fields
+// of a oneof always track it, whatever their field_presence.
+func HasPresence(field pgs.Field) bool {
+	if field.Type().IsRepeated() || field.Type().IsMap() {
+		return false
+	}
+	if field.Type().IsEmbed() || field.InOneOf() {
+		return true
+	}
+	return FieldFeatures(field).GetFieldPresence() != descriptorpb.FeatureSet_IMPLICIT
+}
+
+// OpenEnum reports whether the runtime keeps unknown values of the enum, which
+// getters return as their Integer.
+func OpenEnum(enum pgs.Enum) bool {
+	return EnumFeatures(enum).GetEnumType() == descriptorpb.FeatureSet_OPEN
+}
@@ -0,0 +1,270 @@
+package ruby_types
+
+import (
+	"fmt"
+	"strings"
+
+	pgs "github.com/lyft/protoc-gen-star"
+)
+
+// Ruby constant names as assigned by protoc's ruby generator, see
+// https://github.com/protocolbuffers/protobuf/blob/main/src/google/protobuf/compiler/ruby/ruby_generator.cc
+// The generated code must reference exactly the constants the `_pb.rb` files define.
+
+func (tm TypeMapper) RubyPackage(file pgs.File) string {
+	return strings.Join(tm.rubyPackageModules(file), "::")
+}
+
+func (tm TypeMapper) RubyMessageType(entity EntityWithParent) string {
+	names := make([]string, 0)
+	outer := entity
+	ok := true
+	for ok {
+		names = append([]string{outer.Name().String()}, names...)
+		outer, ok = outer.Parent().(pgs.Message)
+	}
+	return rubyConstant(tm.RubyPackage(entity.File()), names)
+}
+
+// RubyExtensionModule returns the module an extension's helpers are declared
+// in, named after it in the file or message it's defined in:
+// acme.Invoice.priority_level -> Acme::Invoice::PriorityLevelExtension
+// The runtime doesn't define it, an extension is only looked up by its name
+// in the descriptor pool.
+func (tm TypeMapper) RubyExtensionModule(extension pgs.Extension) string {
+	scope := tm.RubyPackage(extension.File())
+	if message, ok := extension.DefinedIn().(pgs.Message); ok {
+		scope = tm.RubyMessageType(message)
+	}
+	name := rubifyConstant(packageToModule(extension.Name().String()))
+	return fmt.Sprintf("%s::%sExtension", scope, name)
+}
+
+// RubyServiceModule returns the module grpc's ruby plugin defines the Service
+// and Stub of a service in. Its name is capitalized and its underscores
+// removed, capitalizing the letter after them: foo_bar -> FooBar
+// See: https://github.com/grpc/grpc/blob/master/src/compiler/ruby_generator_string-inl.h
+func (tm TypeMapper) RubyServiceModule(service pgs.Service) string {
+	modules := append(tm.grpcPackageModules(service.File()), modularize(service.Name().String()))
+	return strings.Join(modules, "::")
+}
+
+// RubyServiceNamespaces returns the modules enclosing the file's service
+// modules, outermost first, like RubyNamespaces.
+func (tm TypeMapper) RubyServiceNamespaces(file pgs.File) []string {
+	namespaces := make([]string, 0)
+	modules := tm.grpcPackageModules(file)
+	for i := range modules {
+		namespaces = append(namespaces, strings.Join(modules[:i+1], "::"))
+	}
+	return namespaces
+}
+
+// grpcPackageModules returns the modules grpc's ruby plugin nests services in,
+// which differ from the messages' for a ruby_package in the `A::B::C` form:
+// grpc splits it on `::` and modularizes each component like the proto
+// package's, so NamingTest::Custom_pkg becomes NamingTest::CustomPkg.
+// See: https://github.com/grpc/grpc/blob/master/src/compiler/ruby_generator_helpers-inl.h
+func (tm TypeMapper) grpcPackageModules(file pgs.File) []string {
+	pkg := file.Descriptor().GetPackage()
+	if modules, ok := tm.mappedNamespace(pkg); ok {
+		return modules
+	}
+	if rubyPackage := file.Descriptor().GetOptions().GetRubyPackage(); rubyPackage != "" {
+		pkg = strings.ReplaceAll(strings.TrimPrefix(rubyPackage, "::"), "::", ".")
+	}
+	modules := make([]string, 0)
+	if pkg != "" {
+		for _, module := range strings.Split(pkg, ".") {
+			modules = append(modules, modularize(module))
+		}
+	}
+	if tm.NamespacePrefix != "" && pkg != pgs.WellKnownTypePackage.String() {
+		modules = append(strings.Split(tm.NamespacePrefix, "::"), modules...)
+	}
+	return modules
+}
+
+func modularize(name string) string {
+	if name == "" {
+		return name
+	}
+	var b strings.Builder
+	b.WriteByte(upperChar(name[0]))
+	for i := 1; i < len(name); i++ {
+		switch {
+		case name[i] == '_':
+		case name[i-1] == '_':
+			b.WriteByte(upperChar(name[i]))
+		default:
+			b.WriteByte(name[i])
+		}
+	}
+	return b.String()
+}
+
+// RubyNamespaces returns the modules enclosing the file's types, outermost
+// first, so they can be declared without relying on other RBI files:
+// Foo, Foo::Bar, Foo::Bar::Baz
+func (tm TypeMapper) RubyNamespaces(file pgs.File) []string {
+	namespaces := make([]string, 0)
+	modules := tm.rubyPackageModules(file)
+	for i := range modules {
+		namespaces = append(namespaces, strings.Join(modules[:i+1], "::"))
+	}
+	return namespaces
+}
+
+// The Namespaces parameter takes precedence over the file's ruby_package, and
+// NamespacePrefix applies to the packages it doesn't map, except for the well
+// known types which are defined by the google-protobuf gem.
+func (tm TypeMapper) rubyPackageModules(file pgs.File) []string {
+	pkg := file.Descriptor().GetPackage()
+	if modules, ok := tm.mappedNamespace(pkg); ok {
+		return modules
+	}
+	modules := rubyPackageModules(pkg, file.Descriptor().GetOptions().GetRubyPackage())
+	if tm.NamespacePrefix != "" && pkg != pgs.WellKnownTypePackage.String() {
+		modules = append(strings.Split(tm.NamespacePrefix, "::"), modules...)
+	}
+	return modules
+}
+
+// mappedNamespace looks up the longest mapped package that is, or encloses,
+// pkg. Packages nested in a mapped one are nested in its namespace:
+// with acme=Vendor::Acme, acme.billing becomes Vendor::Acme::Billing
+func (tm TypeMapper) mappedNamespace(pkg string) ([]string, bool) {
+	for prefix := pkg; prefix != ""; {
+		if namespace, ok := tm.Namespaces[prefix]; ok {
+			modules := strings.Split(namespace, "::")
+			if nested := strings.TrimPrefix(pkg, prefix); nested != "" {
+				modules = append(modules, rubyPackageModules(strings.TrimPrefix(nested, "."), "")...)
+			}
+			return modules, true
+		}
+		i := strings.LastIndex(prefix, ".")
+		if i < 0 {
+			break
+		}
+		prefix = prefix[:i]
+	}
+	return nil, false
+}
+
+// A ruby_package in the `A::B::C` form is used as is, bar a leading `::`, any
+// other is split on dots like the proto package.
+func rubyPackageModules(pkg string, rubyPackageOption string) []string {
+	if rubyPackageOption != "" {
+		if strings.Contains(rubyPackageOption, "::") {
+			return strings.Split(strings.TrimPrefix(rubyPackageOption, "::"), "::")
+		}
+		pkg = rubyPackageOption
+	}
+	if pkg == "" {
+		return []string{}
+	}
+	modules := strings.Split(pkg, ".")
+	for i, module := range modules {
+		modules[i] = packageToModule(module)
+	}
+	return modules
+}
+
+// rubyConstant returns the constant for a message or enum, given the names of
+// its enclosing messages and its own.
+func rubyConstant(rubyPackage string, names []string) string {
+	constants := make([]string, len(names))
+	for i, name := range names {
+		constants[i] = rubifyConstant(name)
+	}
+	return fmt.Sprintf("%s::%s", rubyPackage, strings.Join(constants, "::"))
+}
+
+// packageToModule converts a snake_case package component to PascalCase:
+// foo_bar_baz -> FooBarBaz
+func packageToModule(name string) string {
+	var b strings.Builder
+	nextUpper := true
+	for i := 0; i < len(name); i++ {
+		if name[i] == '_' {
+			nextUpper = true
+			continue
+		}
+		if nextUpper {
+			b.WriteByte(upperChar(name[i]))
+		} else {
+			b.WriteByte(name[i])
+		}
+		nextUpper = false
+	}
+	return b.String()
+}
+
+// rubifyConstant makes a message or enum name a valid constant: a leading
+// lowercase letter is capitalized, a leading non-letter gets a PB_ prefix.
+func rubifyConstant(name string) string {
+	if name == "" {
+		return name
+	}
+	if isLower(name[0]) {
+		return string(upperChar(name[0])) + name[1:]
+	}
+	if !isLower(name[0]) && !isUpper(name[0]) {
+		return "PB_" + name
+	}
+	return name
+}
+
+// protoc's conversions are ASCII only, independent of the locale.
+
+func isLower(c byte) bool { return c >= 'a' && c <= 'z' }
+
+func isUpper(c byte) bool { return c >= 'A' && c <= 'Z' }
+
+func upperChar(c byte) byte {
+	if isLower(c) {
+		return c - 'a' + 'A'
+	}
+	return c
+}
+
+type RubyEnumValue struct {
+	Name  string
+	Value int32
+	// Constant is the name the runtime defines the value as, or empty if it
+	// doesn't define one.
+	Constant string
+}
+
+// RubyEnumValues applies the runtime's rules for defining enum values as
+// constants of the enum module: a leading lowercase letter is capitalized, and
+// values starting with anything else but an uppercase letter are skipped with
+// a warning. A value whose constant was already defined by an earlier value
+// isn't redeclared either.
+// See: https://github.com/protocolbuffers/protobuf/blob/main/ruby/ext/google/protobuf_c/message.c
+func RubyEnumValues(enum pgs.Enum) []RubyEnumValue {
+	values := make([]RubyEnumValue, 0, len(enum.Values()))
+	defined := make(map[string]bool)
+	for _, value := range enum.Values() {
+		name := value.Name().String()
+		constant := rubyEnumConstant(name)
+		if defined[constant] {
+			constant = ""
+		}
+		if constant != "" {
+			defined[constant] = true
+		}
+		values = append(values, RubyEnumValue{Name: name, Value: value.Value(), Constant: constant})
+	}
+	return values
+}
+
+func rubyEnumConstant(name string) string {
+	if name == "" || isUpper(name[0]) {
+		return name
+	}
+	if isLower(name[0]) {
+		return string(upperChar(name[0])) + name[1:]
+	}
+	return ""
+}
@@ -0,0 +1,161 @@
+package ruby_types
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	pgs "github.com/lyft/protoc-gen-star"
+)
+
+var (
+	protoPackage     = regexp.MustCompile(`(?m)^package\s+([\w.]+)\s*;`)
+	protoRubyPackage = regexp.MustCompile(`(?m)^option\s+ruby_package\s*=\s*"([^"]*)"\s*;`)
+	rbModule         = regexp.MustCompile(`^\s*module\s+(\S+)$`)
+	rbEnd            = regexp.MustCompile(`^\s*end$`)
+	rbClass          = regexp.MustCompile(`^\s*class\s+(\S+)$`)
+	rbService        = regexp.MustCompile(`^\s*self\.service_name = '([^']+)'$`)
+	rbAssignment     = regexp.MustCompile(`^\s*(\S+) = ::Google::Protobuf::DescriptorPool\.generated_pool\.lookup\("([^"]+)"\)\.(msgclass|enummodule)$`)
+)
+
+// Compares the constants declared in the checked-in _pb.rb files, generated by
+// protoc's ruby_out, against the ones we compute from the same descriptors.
+func TestRubyConstantParity(t *testing.T) {
+	protos, err := filepath.Glob("../testdata/*.proto")
+	if err != nil {
+		t.Fatal(err)
+	}
+	subdirProtos, err := filepath.Glob("../testdata/*/*.proto")
+	if err != nil {
+		t.Fatal(err)
+	}
+	protos = append(protos, subdirProtos...)
+
+	checked := 0
+	for _, protoPath := range protos {
+		source, err := ioutil.ReadFile(protoPath)
+		if err != nil {
+			t.Fatal(err)
+		}
+		rb, err := ioutil.ReadFile(strings.TrimSuffix(protoPath, ".proto") + "_pb.rb")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		pkg := ""
+		if match := protoPackage.FindSubmatch(source); match != nil {
+			pkg = string(match[1])
+		}
+		rubyPackageOption := ""
+		if match := protoRubyPackage.FindSubmatch(source); match != nil {
+			rubyPackageOption = string(match[1])
+		}
+
+		modules := make([]string, 0)
+		for _, line := range strings.Split(string(rb), "\n") {
+			if match := rbModule.FindStringSubmatch(line); match != nil {
+				modules = append(modules, match[1])
+			} else if rbEnd.MatchString(line) && len(modules) > 0 {
+				modules = modules[:len(modules)-1]
+			} else if match := rbAssignment.FindStringSubmatch(line); match != nil {
+				want := strings.Join(append(append([]string{}, modules...), match[1]), "::")
+				names := strings.Split(strings.TrimPrefix(match[2], pkg+"."), ".")
+				got := rubyConstant(strings.Join(rubyPackageModules(pkg, rubyPackageOption), "::"), names)
+				if got != want {
+					t.Errorf("%s: %s: got %s, want %s", protoPath, match[2], got, want)
+				}
+				checked++
+			}
+		}
+	}
+
+	if checked == 0 {
+		t.Error("no constants found in testdata")
+	}
+}
+
+func TestRubyPackageModules(t *testing.T) {
+	tests := []struct {
+		pkg         string
+		rubyPackage string
+		want        string
+	}{
+		{"foo_bar.baz", "", "FooBar::Baz"},
+		{"foo", "acme.billing", "Acme::Billing"},
+		{"foo", "Acme::Billing", "Acme::Billing"},
+		{"foo", "::Acme::Billing", "Acme::Billing"},
+	}
+	for _, tt := range tests {
+		if got := strings.Join(rubyPackageModules(tt.pkg, tt.rubyPackage), "::"); got != tt.want {
+			t.Errorf("rubyPackageModules(%q, %q): got %q, want %q", tt.pkg, tt.rubyPackage, got, tt.want)
+		}
+	}
+}
+
+// Compares the modules of the services in the checked-in _services_pb.rb files,
+// generated by grpc's ruby plugin, against the ones we compute.
+func TestRubyServiceModuleParity(t *testing.T) {
+	rbs, err := filepath.Glob("../testdata/*_services_pb.rb")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	checked := 0
+	for _, rbPath := range rbs {
+		rb, err := ioutil.ReadFile(rbPath)
+		if err != nil {
+			t.Fatal(err)
+		}
+		path := strings.TrimSuffix(filepath.Base(rbPath), "_services_pb.rb") + ".proto"
+		services := make(map[string]pgs.Service)
+		for _, service := range loadFile(t, path).Services() {
+			services[service.FullyQualifiedName()] = service
+		}
+
+		modules := make([]string, 0)
+		for _, line := range strings.Split(string(rb), "\n") {
+			// the service is defined in the Service class of its module
+			if match := rbModule.FindStringSubmatch(line); match != nil {
+				modules = append(modules, match[1])
+			} else if match := rbClass.FindStringSubmatch(line); match != nil {
+				modules = append(modules, match[1])
+			} else if rbEnd.MatchString(line) && len(modules) > 0 {
+				modules = modules[:len(modules)-1]
+			} else if match := rbService.FindStringSubmatch(line); match != nil && len(modules) > 0 {
+				service, ok := services["."+match[1]]
+				if !ok {
+					t.Errorf("%s: no service %s", path, match[1])
+					continue
+				}
+				want := strings.Join(modules[:len(modules)-1], "::")
+				if got := (TypeMapper{}).RubyServiceModule(service); got != want {
+					t.Errorf("%s: %s: got %s, want %s", path, match[1], got, want)
+				}
+				checked++
+			}
+		}
+	}
+
+	if checked == 0 {
+		t.Error("no services found in testdata")
+	}
+}
+
+// Service names as grpc's ruby plugin modularizes them
+func TestModularize(t *testing.T) {
+	tests := map[string]string{
+		"Greeter":     "Greeter",
+		"greeter":     "Greeter",
+		"foo_bar":     "FooBar",
+		"foo__bar_":   "FooBar",
+		"FOO_BAR":     "FOOBAR",
+		"fooBar_baz2": "FooBarBaz2",
+	}
+	for name, want := range tests {
+		if got := modularize(name); got != want {
+			t.Errorf("modularize(%q): got %q, want %q", name, got, want)
+		}
+	}
+}
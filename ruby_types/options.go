@@ -0,0 +1,114 @@
+package ruby_types
+
+import (
+	"github.com/coinbase/protoc-gen-rbi/rbi"
+
+	pgs "github.com/lyft/protoc-gen-star"
+)
+
+// Helpers reading the custom options defined in rbi/options.proto. Options
+// that fail to decode are stripped, and reported, before pgs parses the
+// request.
+
+func readFileOptions(file pgs.File) (*rbi.FileOptions, error) {
+	opts := &rbi.FileOptions{}
+	_, err := file.Extension(rbi.E_File, opts)
+	return opts, err
+}
+
+func readMessageOptions(message pgs.Message) (*rbi.MessageOptions, error) {
+	opts := &rbi.MessageOptions{}
+	_, err := message.Extension(rbi.E_Message, opts)
+	return opts, err
+}
+
+func readFieldOptions(field pgs.Field) (*rbi.FieldOptions, error) {
+	opts := &rbi.FieldOptions{}
+	_, err := field.Extension(rbi.E_Field, opts)
+	return opts, err
+}
+
+func fileOptions(file pgs.File) *rbi.FileOptions {
+	opts, _ := readFileOptions(file)
+	return opts
+}
+
+func messageOptions(message pgs.Message) *rbi.MessageOptions {
+	opts, _ := readMessageOptions(message)
+	return opts
+}
+
+func fieldOptions(field pgs.Field) *rbi.FieldOptions {
+	opts, _ := readFieldOptions(field)
+	return opts
+}
+
+func SkipFile(file pgs.File) bool {
+	return fileOptions(file).GetSkip()
+}
+
+// a message is skipped along with everything nested in it
+func skipEntity(entity EntityWithParent) bool {
+	if message, ok := entity.(pgs.Message); ok && messageOptions(message).GetSkip() {
+		return true
+	}
+	if parent, ok := entity.Parent().(pgs.Message); ok {
+		return skipEntity(parent)
+	}
+	return false
+}
+
+func Messages(file pgs.File) []pgs.Message {
+	messages := make([]pgs.Message, 0)
+	for _, message := range file.AllMessages() {
+		if !skipEntity(message) {
+			messages = append(messages, message)
+		}
+	}
+	return messages
+}
+
+func Enums(file pgs.File) []pgs.Enum {
+	enums := make([]pgs.Enum, 0)
+	for _, enum := range file.AllEnums() {
+		if !skipEntity(enum) {
+			enums = append(enums, enum)
+		}
+	}
+	return enums
+}
+
+func Fields(message pgs.Message) []pgs.Field {
+	fields := make([]pgs.Field, 0)
+	for _, field := range message.Fields() {
+		if !fieldOptions(field).GetSkip() {
+			fields = append(fields, field)
+		}
+	}
+	return fields
+}
+
+// Extensions returns the extensions defined in the file, at its top level then
+// in its messages, except those of skipped messages and skipped extensions.
+func Extensions(file pgs.File) []pgs.Extension {
+	extensions := make([]pgs.Extension, 0)
+	add := func(defined []pgs.Extension) {
+		for _, extension := range defined {
+			if !fieldOptions(extension).GetSkip() {
+				extensions = append(extensions, extension)
+			}
+		}
+	}
+	add(file.DefinedExtensions())
+	for _, message := range Messages(file) {
+		add(message.DefinedExtensions())
+	}
+	return extensions
+}
+
+// extensions have no message, only the file or message they're defined in
+func untypedField(field pgs.Field) bool {
+	return fieldOptions(field).GetUntyped() ||
+		(field.Message() != nil && messageOptions(field.Message()).GetUntyped()) ||
+		fileOptions(field.File()).GetUntyped()
+}
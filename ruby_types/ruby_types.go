@@ -2,7 +2,6 @@ package ruby_types
 
 import (
 	"fmt"
-	"log"
 	"strings"
 
 	pgs "github.com/lyft/protoc-gen-star"
@@ -32,198 +31,350 @@ type EntityWithParent interface {
 	Parent() pgs.ParentEntity
 }
 
-func RubyPackage(file pgs.File) string {
-	pkg := file.Descriptor().GetOptions().GetRubyPackage()
-	if pkg == "" {
-		pkg = file.Descriptor().GetPackage()
-	}
-	pkg = strings.Replace(pkg, ".", "::", -1)
-	// right now the ruby_out doesn't camelcase the ruby_package, but this results in invalid classes, so do it:
-	return upperCamelCase(pkg)
+// TypeMapper renders field types according to the generator parameters.
+type TypeMapper struct {
+	// Type open enum getters as Symbol, even though the runtime returns an
+	// Integer for values without a matching name.
+	StrictEnumGetters bool
+	// Reference Ruby core classes from the top level (`::String`), so they
+	// can't be shadowed by messages of the same name in the proto package.
+	QualifyCoreTypes bool
+	// Ruby namespaces (`Acme::Billing`) replacing those of proto packages
+	// (`acme.billing`) and the packages nested in them.
+	Namespaces map[string]string
+	// Namespace prepended to those of the packages not in Namespaces.
+	NamespacePrefix string
+}
+
+var coreTypes = map[string]bool{
+	"Float":   true,
+	"Integer": true,
+	"String":  true,
+	"Symbol":  true,
 }
 
-func RubyMessageType(entity EntityWithParent) string {
-	names := make([]string, 0)
-	outer := entity
-	ok := true
-	for ok {
-		name := outer.Name().String()
-		names = append([]string{strings.Title(name)}, names...)
-		outer, ok = outer.Parent().(pgs.Message)
+// CoreType qualifies the bare Ruby core classes in the type expression, e.g.
+// `T.any(Symbol, String)` becomes `T.any(::Symbol, ::String)`.
+func (tm TypeMapper) CoreType(rubyType string) string {
+	if !tm.QualifyCoreTypes {
+		return rubyType
+	}
+	var b strings.Builder
+	start := -1
+	for i := 0; i <= len(rubyType); i++ {
+		if i < len(rubyType) && isConstantChar(rubyType[i]) {
+			if start < 0 {
+				start = i
+			}
+			continue
+		}
+		if start >= 0 {
+			constant := rubyType[start:i]
+			if coreTypes[constant] && (start == 0 || rubyType[start-1] != '.') {
+				b.WriteString("::")
+			}
+			b.WriteString(constant)
+			start = -1
+		}
+		if i < len(rubyType) {
+			b.WriteByte(rubyType[i])
+		}
 	}
-	return fmt.Sprintf("%s::%s", RubyPackage(entity.File()), strings.Join(names, "::"))
+	return b.String()
 }
 
-func RubyGetterFieldType(field pgs.Field) string {
-	return rubyFieldType(field, methodTypeGetter)
+// constants are scanned whole, including their `::` separators, so only
+// top level references are matched
+func isConstantChar(c byte) bool {
+	return isLower(c) || isUpper(c) || (c >= '0' && c <= '9') || c == '_' || c == ':'
 }
 
-func RubySetterFieldType(field pgs.Field) string {
-	return rubyFieldType(field, methodTypeSetter)
+func (tm TypeMapper) RubyGetterFieldType(field pgs.Field) (string, error) {
+	return tm.rubyFieldType(field, methodTypeGetter)
 }
 
-func RubyInitializerFieldType(field pgs.Field) string {
-	return rubyFieldType(field, methodTypeInitializer)
+func (tm TypeMapper) RubySetterFieldType(field pgs.Field) (string, error) {
+	return tm.rubyFieldType(field, methodTypeSetter)
 }
 
-func rubyFieldType(field pgs.Field, mt methodType) string {
+func (tm TypeMapper) RubyInitializerFieldType(field pgs.Field) (string, error) {
+	return tm.rubyFieldType(field, methodTypeInitializer)
+}
+
+func (tm TypeMapper) rubyFieldType(field pgs.Field, mt methodType) (string, error) {
+	if untypedField(field) {
+		return "T.untyped", nil
+	}
+
 	var rubyType string
+	var err error
 
 	t := field.Type()
 
 	if t.IsMap() {
-		rubyType = rubyFieldMapType(field, t, mt)
+		rubyType, err = tm.rubyFieldMapType(field, t, mt)
 	} else if t.IsRepeated() {
-		rubyType = rubyFieldRepeatedType(field, t, mt)
+		rubyType, err = tm.rubyFieldRepeatedType(field, t, mt)
 	} else {
-		rubyType = rubyProtoTypeElem(field, t, mt)
+		rubyType, err = tm.rubyFieldElem(field, t, mt)
+	}
+	if err != nil {
+		return "", err
 	}
 
 	// initializer fields can be passed a `nil` value for all field types
 	// messages are already wrapped so we skip those
 	if mt == methodTypeInitializer && (t.IsMap() || t.IsRepeated() || t.ProtoType() != pgs.MessageT) {
-		return fmt.Sprintf("T.nilable(%s)", rubyType)
+		return fmt.Sprintf("T.nilable(%s)", rubyType), nil
 	}
 
-	return rubyType
+	return rubyType, nil
 }
 
-func rubyFieldMapType(field pgs.Field, ft pgs.FieldType, mt methodType) string {
+func (tm TypeMapper) rubyFieldMapType(field pgs.Field, ft pgs.FieldType, mt methodType) (string, error) {
 	if mt == methodTypeSetter {
-		return "::Google::Protobuf::Map"
+		return "::Google::Protobuf::Map", nil
+	}
+	key, err := tm.rubyProtoTypeElem(ft.Key(), mt)
+	if err != nil {
+		return "", err
 	}
-	key := rubyProtoTypeElem(field, ft.Key(), mt)
-	value := rubyProtoTypeElem(field, ft.Element(), mt)
-	return fmt.Sprintf("T::Hash[%s, %s]", key, value)
+	value, err := tm.rubyFieldElem(field, ft.Element(), mt)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("T::Hash[%s, %s]", key, value), nil
 }
 
-func rubyFieldRepeatedType(field pgs.Field, ft pgs.FieldType, mt methodType) string {
+func (tm TypeMapper) rubyFieldRepeatedType(field pgs.Field, ft pgs.FieldType, mt methodType) (string, error) {
 	// An enumerable/array is not accepted at the setter
 	// See: https://github.com/protocolbuffers/protobuf/issues/4969
 	// See: https://developers.google.com/protocol-buffers/docs/reference/ruby-generated#repeated-fields
 	if mt == methodTypeSetter {
-		return "::Google::Protobuf::RepeatedField"
+		return "::Google::Protobuf::RepeatedField", nil
+	}
+	value, err := tm.rubyFieldElem(field, ft.Element(), mt)
+	if err != nil {
+		return "", err
 	}
-	value := rubyProtoTypeElem(field, ft.Element(), mt)
-	return fmt.Sprintf("T::Array[%s]", value)
+	return fmt.Sprintf("T::Array[%s]", value), nil
+}
+
+var wrapperValueTypes = map[pgs.WellKnownType]pgs.ProtoType{
+	pgs.DoubleValueWKT: pgs.DoubleT,
+	pgs.FloatValueWKT:  pgs.FloatT,
+	pgs.Int64ValueWKT:  pgs.Int64T,
+	pgs.UInt64ValueWKT: pgs.UInt64T,
+	pgs.Int32ValueWKT:  pgs.Int32T,
+	pgs.UInt32ValueWKT: pgs.UInt32T,
+	pgs.BoolValueWKT:   pgs.BoolT,
+	pgs.StringValueWKT: pgs.StringT,
+	pgs.BytesValueWKT:  pgs.BytesT,
 }
 
-func RubyFieldValue(field pgs.Field) string {
+func wrapperValueType(field pgs.Field) (pgs.ProtoType, bool) {
+	t := field.Type()
+	if t.IsRepeated() || t.IsMap() || !t.IsEmbed() || !t.Embed().IsWellKnown() {
+		return 0, false
+	}
+	pt, ok := wrapperValueTypes[t.Embed().WellKnownType()]
+	return pt, ok
+}
+
+// The ruby runtime generates `<field>_as_value` accessors reading/writing the
+// unwrapped scalar for singular fields of the google.protobuf wrapper types.
+// The initializer only accepts the wrapper message, so it is left as is.
+// See: https://developers.google.com/protocol-buffers/docs/reference/ruby-generated#wrapper-types
+func IsWrapperField(field pgs.Field) bool {
+	_, ok := wrapperValueType(field)
+	return ok
+}
+
+func (tm TypeMapper) RubyWrapperGetterType(field pgs.Field) string {
+	return tm.rubyWrapperValueType(field, methodTypeGetter)
+}
+
+func (tm TypeMapper) RubyWrapperSetterType(field pgs.Field) string {
+	return tm.rubyWrapperValueType(field, methodTypeSetter)
+}
+
+func (tm TypeMapper) rubyWrapperValueType(field pgs.Field, mt methodType) string {
+	if untypedField(field) {
+		return "T.untyped"
+	}
+	pt, _ := wrapperValueType(field)
+	return fmt.Sprintf("T.nilable(%s)", tm.CoreType(rubyScalarType(pt, mt)))
+}
+
+func (tm TypeMapper) RubyFieldValue(field pgs.Field) (string, error) {
 	t := field.Type()
 	if t.IsMap() {
-		key := rubyMapType(t.Key())
+		key, err := rubyMapType(t.Key())
+		if err != nil {
+			return "", err
+		}
 		if t.Element().ProtoType() == pgs.MessageT {
-			value := RubyMessageType(t.Element().Embed())
-			return fmt.Sprintf("::Google::Protobuf::Map.new(%s, :message, %s)", key, value)
+			value := tm.RubyMessageType(t.Element().Embed())
+			return fmt.Sprintf("::Google::Protobuf::Map.new(%s, :message, %s)", key, value), nil
 		}
-		value := rubyMapType(t.Element())
-		return fmt.Sprintf("::Google::Protobuf::Map.new(%s, %s)", key, value)
+		value, err := rubyMapType(t.Element())
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("::Google::Protobuf::Map.new(%s, %s)", key, value), nil
 	} else if t.IsRepeated() {
-		return "[]"
+		return "[]", nil
 	}
-	return rubyProtoTypeValue(field, t)
+	return rubyProtoTypeValue(t)
 }
 
-func rubyProtoTypeElem(field pgs.Field, ft FieldType, mt methodType) string {
-	pt := ft.ProtoType()
-	if pt.IsInt() {
-		return "Integer"
+// the (rbi.field).type option replaces the inferred scalar, element or map value type
+func (tm TypeMapper) rubyFieldElem(field pgs.Field, ft FieldType, mt methodType) (string, error) {
+	if override := fieldOptions(field).GetType(); override != "" {
+		return override, nil
 	}
-	if pt.IsNumeric() {
-		return "Float"
-	}
-	if pt == pgs.StringT || pt == pgs.BytesT {
-		return "String"
+	return tm.rubyProtoTypeElem(ft, mt)
+}
+
+type scalarType struct {
+	getter   string
+	accepted string
+}
+
+// Ruby types of scalar fields, as returned by getters and as accepted by
+// setters and initializers. On assignment the runtime:
+//   - converts an Integer to Float for float and double fields
+//   - takes only an Integer for integer fields (an integral Float is also
+//     converted, but any other Float raises a RangeError, so it's left out)
+//   - takes only true or false for bool fields
+//   - converts a Symbol to String, and re-encodes Strings as UTF-8, for string fields
+//   - takes only a String for bytes fields, copied as binary (ASCII-8BIT)
+//
+// Enums take a Symbol, String or Integer and are handled separately.
+// See: https://developers.google.com/protocol-buffers/docs/reference/ruby-generated#fields
+var scalarTypes = map[pgs.ProtoType]scalarType{
+	pgs.DoubleT:  {"Float", "T.any(Float, Integer)"},
+	pgs.FloatT:   {"Float", "T.any(Float, Integer)"},
+	pgs.Int64T:   {"Integer", "Integer"},
+	pgs.UInt64T:  {"Integer", "Integer"},
+	pgs.Int32T:   {"Integer", "Integer"},
+	pgs.Fixed64T: {"Integer", "Integer"},
+	pgs.Fixed32T: {"Integer", "Integer"},
+	pgs.BoolT:    {"T::Boolean", "T::Boolean"},
+	pgs.StringT:  {"String", "T.any(String, Symbol)"},
+	pgs.BytesT:   {"String", "String"},
+	pgs.UInt32T:  {"Integer", "Integer"},
+	pgs.SFixed32: {"Integer", "Integer"},
+	pgs.SFixed64: {"Integer", "Integer"},
+	pgs.SInt32:   {"Integer", "Integer"},
+	pgs.SInt64:   {"Integer", "Integer"},
+}
+
+// pt must be one of scalarTypes
+func rubyScalarType(pt pgs.ProtoType, mt methodType) string {
+	st := scalarTypes[pt]
+	if mt == methodTypeGetter {
+		return st.getter
 	}
-	if pt == pgs.BoolT {
-		return "T::Boolean"
+	return st.accepted
+}
+
+func (tm TypeMapper) rubyProtoTypeElem(ft FieldType, mt methodType) (string, error) {
+	pt := ft.ProtoType()
+	if _, ok := scalarTypes[pt]; ok {
+		return tm.CoreType(rubyScalarType(pt, mt)), nil
 	}
 	if pt == pgs.EnumT {
 		if mt == methodTypeGetter {
-			return "Symbol"
+			// open enums return unknown values as their Integer
+			if OpenEnum(ft.Enum()) && !tm.StrictEnumGetters {
+				return tm.CoreType("T.any(Symbol, Integer)"), nil
+			}
+			return tm.CoreType("Symbol"), nil
 		}
-		return "T.any(Symbol, String, Integer)"
+		return tm.CoreType("T.any(Symbol, String, Integer)"), nil
 	}
 	if pt == pgs.MessageT {
-		return fmt.Sprintf("T.nilable(%s)", RubyMessageType(ft.Embed()))
+		return fmt.Sprintf("T.nilable(%s)", tm.RubyMessageType(ft.Embed())), nil
 	}
-	log.Panicf("Unsupported field type for field: %v\n", field.Name().String())
-	return ""
+	return "", unsupportedType(pt)
 }
 
-func rubyProtoTypeValue(field pgs.Field, ft FieldType) string {
+func rubyProtoTypeValue(ft FieldType) (string, error) {
 	pt := ft.ProtoType()
 	if pt.IsInt() {
-		return "0"
+		return "0", nil
 	}
 	if pt.IsNumeric() {
-		return "0.0"
+		return "0.0", nil
 	}
 	if pt == pgs.StringT || pt == pgs.BytesT {
-		return "\"\""
+		return "\"\"", nil
 	}
 	if pt == pgs.BoolT {
-		return "false"
+		return "false", nil
 	}
 	if pt == pgs.EnumT {
-		return fmt.Sprintf(":%s", ft.Enum().Values()[0].Name().String())
+		return fmt.Sprintf(":%s", ft.Enum().Values()[0].Name().String()), nil
 	}
 	if pt == pgs.MessageT {
-		return "nil"
+		return "nil", nil
 	}
-	log.Panicf("Unsupported field type for field: %v\n", field.Name().String())
-	return ""
+	return "", unsupportedType(pt)
 }
 
-func rubyMapType(ft FieldType) string {
+func rubyMapType(ft FieldType) (string, error) {
 	switch ft.ProtoType() {
 	case pgs.DoubleT:
-		return ":double"
+		return ":double", nil
 	case pgs.FloatT:
-		return ":float"
+		return ":float", nil
 	case pgs.Int64T:
-		return ":int64"
+		return ":int64", nil
 	case pgs.UInt64T:
-		return ":uint64"
+		return ":uint64", nil
 	case pgs.Int32T:
-		return ":int32"
+		return ":int32", nil
 	case pgs.Fixed64T:
-		return ":fixed64"
+		return ":fixed64", nil
 	case pgs.Fixed32T:
-		return ":fixed32"
+		return ":fixed32", nil
 	case pgs.BoolT:
-		return ":bool"
+		return ":bool", nil
 	case pgs.StringT:
-		return ":string"
+		return ":string", nil
 	case pgs.BytesT:
-		return ":bytes"
+		return ":bytes", nil
 	case pgs.UInt32T:
-		return ":uint32"
+		return ":uint32", nil
 	case pgs.EnumT:
-		return ":enum"
+		return ":enum", nil
 	case pgs.SFixed32:
-		return ":sfixed32"
+		return ":sfixed32", nil
 	case pgs.SFixed64:
-		return ":sfixed64"
+		return ":sfixed64", nil
 	case pgs.SInt32:
-		return ":sint32"
+		return ":sint32", nil
 	case pgs.SInt64:
-		return ":sint64"
+		return ":sint64", nil
 	}
-	log.Panicf("Unsupported map field type\n")
-	return ""
+	return "", unsupportedType(ft.ProtoType())
+}
+
+func unsupportedType(pt pgs.ProtoType) error {
+	return fmt.Errorf("unsupported field type %s", strings.ToLower(strings.TrimPrefix(pt.String(), "TYPE_")))
 }
 
-func RubyMethodParamType(method pgs.Method) string {
-	return rubyMethodType(method.Input(), method.ClientStreaming())
+func (tm TypeMapper) RubyMethodParamType(method pgs.Method) string {
+	return tm.rubyMethodType(method.Input(), method.ClientStreaming())
 }
 
-func RubyMethodReturnType(method pgs.Method) string {
-	return rubyMethodType(method.Output(), method.ServerStreaming())
+func (tm TypeMapper) RubyMethodReturnType(method pgs.Method) string {
+	return tm.rubyMethodType(method.Output(), method.ServerStreaming())
 }
 
-func rubyMethodType(message pgs.Message, streaming bool) string {
-	t := RubyMessageType(message)
+func (tm TypeMapper) rubyMethodType(message pgs.Message, streaming bool) string {
+	t := tm.RubyMessageType(message)
 	if streaming {
 		return fmt.Sprintf("T::Enumerable[%s]", t)
 	}
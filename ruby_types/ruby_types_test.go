@@ -0,0 +1,109 @@
+package ruby_types
+
+import (
+	"io/ioutil"
+	"testing"
+
+	pgs "github.com/lyft/protoc-gen-star"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+// loadFile returns a testdata proto, parsed from testdata/descriptor_set.pb
+// along with its imports like protoc passes it to the plugin.
+func loadFile(t *testing.T, path string) pgs.File {
+	data, err := ioutil.ReadFile("../testdata/descriptor_set.pb")
+	if err != nil {
+		t.Fatal(err)
+	}
+	set := &descriptorpb.FileDescriptorSet{}
+	if err := proto.Unmarshal(data, set); err != nil {
+		t.Fatal(err)
+	}
+
+	// the set lists the imports before the files importing them
+	needed := map[string]bool{path: true}
+	var files []*descriptorpb.FileDescriptorProto
+	for i := len(set.File) - 1; i >= 0; i-- {
+		if file := set.File[i]; needed[file.GetName()] {
+			for _, dependency := range file.GetDependency() {
+				needed[dependency] = true
+			}
+			files = append([]*descriptorpb.FileDescriptorProto{file}, files...)
+		}
+	}
+	ast := pgs.ProcessCodeGeneratorRequest(pgs.InitMockDebugger(), &pluginpb.CodeGeneratorRequest{
+		FileToGenerate: []string{path},
+		ProtoFile:      files,
+	})
+	file, ok := ast.Targets()[path]
+	if !ok {
+		t.Fatalf("no file %s in the descriptor set", path)
+	}
+	return file
+}
+
+// loadMessage returns a message of a testdata proto.
+func loadMessage(t *testing.T, path, name string) pgs.Message {
+	for _, message := range loadFile(t, path).AllMessages() {
+		if message.Name().String() == name {
+			return message
+		}
+	}
+	t.Fatalf("no message %s in %s", name, path)
+	return nil
+}
+
+// The scalar fields of testdata.subdir.AllTypes
+func TestRubyScalarType(t *testing.T) {
+	tests := []struct {
+		field       string
+		setter      string
+		initializer string
+	}{
+		{"double_value", "T.any(::Float, ::Integer)", "T.nilable(T.any(::Float, ::Integer))"},
+		{"float_value", "T.any(::Float, ::Integer)", "T.nilable(T.any(::Float, ::Integer))"},
+		{"int32_value", "::Integer", "T.nilable(::Integer)"},
+		{"int64_value", "::Integer", "T.nilable(::Integer)"},
+		{"uint32_value", "::Integer", "T.nilable(::Integer)"},
+		{"uint64_value", "::Integer", "T.nilable(::Integer)"},
+		{"sint32_value", "::Integer", "T.nilable(::Integer)"},
+		{"sint64_value", "::Integer", "T.nilable(::Integer)"},
+		{"fixed32_value", "::Integer", "T.nilable(::Integer)"},
+		{"fixed64_value", "::Integer", "T.nilable(::Integer)"},
+		{"sfixed32_value", "::Integer", "T.nilable(::Integer)"},
+		{"sfixed64_value", "::Integer", "T.nilable(::Integer)"},
+		{"bool_value", "T::Boolean", "T.nilable(T::Boolean)"},
+		{"string_value", "T.any(::String, ::Symbol)", "T.nilable(T.any(::String, ::Symbol))"},
+		{"bytes_value", "::String", "T.nilable(::String)"},
+	}
+
+	fields := make(map[string]pgs.Field)
+	for _, field := range loadMessage(t, "subdir/messages.proto", "AllTypes").Fields() {
+		fields[field.Name().String()] = field
+	}
+	tm := TypeMapper{QualifyCoreTypes: true}
+	types := make(map[pgs.ProtoType]bool)
+	for _, tt := range tests {
+		t.Run(tt.field, func(t *testing.T) {
+			field, ok := fields[tt.field]
+			if !ok {
+				t.Fatalf("no field %s", tt.field)
+			}
+			types[field.Type().ProtoType()] = true
+			if got, err := tm.RubySetterFieldType(field); got != tt.setter || err != nil {
+				t.Errorf("setter: got %q, %v, want %q", got, err, tt.setter)
+			}
+			if got, err := tm.RubyInitializerFieldType(field); got != tt.initializer || err != nil {
+				t.Errorf("initializer: got %q, %v, want %q", got, err, tt.initializer)
+			}
+		})
+	}
+
+	for pt := range scalarTypes {
+		if !types[pt] {
+			t.Errorf("no field of scalar type %s", pt)
+		}
+	}
+}